@@ -0,0 +1,70 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// Accelerate returns estimation of limit of sequence of iterates by
+// Wynn's epsilon algorithm. Algorithm is applicable for iterates of
+// any linearly converging method, for example bisection.
+//
+//	Input data:
+//		iterates - sequence of iterates, minimal 3 values
+//	Output data:
+//		x        - estimation of limit
+//		err      - error if some is not ok
+//
+// Table of epsilon algorithm:
+//
+//	e[-1][n]  = 0
+//	e[0][n]   = iterates[n]
+//	e[k+1][n] = e[k-1][n+1] + 1 / (e[k][n+1] - e[k][n])
+//
+// Estimation is the last value of the highest even column.
+func Accelerate(iterates []float64) (x float64, err error) {
+	if len(iterates) < 3 {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("amount of iterates is not enough: %d", len(iterates)),
+		}
+		return
+	}
+	for _, v := range iterates {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			err = ErrorFind{
+				Type: NotValidValue,
+				Err:  fmt.Errorf("iterate is not valid: %s", fmtFloat(v)),
+			}
+			return
+		}
+	}
+	var (
+		prev = make([]float64, len(iterates)+1) // column -1
+		cur  = append([]float64(nil), iterates...)
+	)
+	x = iterates[len(iterates)-1]
+	for k := 0; 1 < len(cur); k++ {
+		next := make([]float64, len(cur)-1)
+		for i := range next {
+			d := cur[i+1] - cur[i]
+			if d == 0 {
+				if k%2 == 0 {
+					// column is converged
+					return cur[i+1], nil
+				}
+				return
+			}
+			next[i] = prev[i+1] + 1/d
+		}
+		if k%2 == 1 {
+			v := next[len(next)-1]
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return
+			}
+			x = v
+		}
+		prev, cur = cur, next
+	}
+	return
+}
@@ -0,0 +1,42 @@
+package root_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestAccelerate(t *testing.T) {
+	// linearly converging sequence
+	var iterates []float64
+	for n := 0; n < 10; n++ {
+		iterates = append(iterates, 1+math.Pow(0.5, float64(n))+0.1*math.Pow(-0.3, float64(n)))
+	}
+	x, err := root.Accelerate(iterates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		raw = math.Abs(iterates[len(iterates)-1] - 1)
+		acc = math.Abs(x - 1)
+	)
+	t.Logf("raw error = %.3e, accelerated error = %.3e", raw, acc)
+	if raw <= acc {
+		t.Errorf("accuracy is not improved")
+	}
+	t.Run("short", func(t *testing.T) {
+		if _, err := root.Accelerate([]float64{1, 2}); err == nil {
+			t.Errorf("short sequence is accepted")
+		}
+	})
+	t.Run("constant", func(t *testing.T) {
+		x, err := root.Accelerate([]float64{3, 3, 3, 3})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if x != 3 {
+			t.Errorf("not valid value: %e", x)
+		}
+	})
+}
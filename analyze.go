@@ -0,0 +1,86 @@
+package root
+
+import "fmt"
+
+// Analyze finds all roots and local extrema of function on interval
+// [minX, maxX].
+//
+//	Input data:
+//		f      - function of variable X
+//		minX   - minimal X
+//		maxX   - maximal X
+//		panels - amount of panels, minimal 2
+//	Output data:
+//		roots  - roots of function in increasing order
+//		minima - X values of local minima in increasing order
+//		maxima - X values of local maxima in increasing order
+//		err    - error if some is not ok
+//
+// Function is sampled on uniform grid of panels:
+//   - root is found by Find in panel with sign change of function;
+//   - extremum is found by golden-section search on two neighbor panels
+//     with sign change of function increment.
+//
+// Extrema on borders of interval are not detected.
+//
+// Notes:
+//   - Panic-free function
+func Analyze(f func(float64) (float64, error), minX, maxX float64, panels int) (roots, minima, maxima []float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	if panels < 2 {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("amount of panels is not valid: %d", panels),
+		}
+		return
+	}
+	// replace borders
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	xs, ys, err := Sample(f, minX, maxX, panels+1)
+	if err != nil {
+		return
+	}
+	for i := 0; i < panels; i++ {
+		// roots
+		if signChange(ys, i) {
+			var root float64
+			if root, err = Find(f, xs[i], xs[i+1]); err != nil {
+				return
+			}
+			roots = append(roots, root)
+		}
+		// extrema
+		if i == 0 {
+			continue
+		}
+		var (
+			before = ys[i] - ys[i-1]
+			after  = ys[i+1] - ys[i]
+		)
+		switch {
+		case before < 0 && 0 < after:
+			var x float64
+			if x, _, err = goldenSection(f, xs[i-1], xs[i+1], nil); err != nil {
+				return
+			}
+			minima = append(minima, x)
+		case 0 < before && after < 0:
+			var x float64
+			if x, _, err = goldenSection(Negate(f), xs[i-1], xs[i+1], nil); err != nil {
+				return
+			}
+			maxima = append(maxima, x)
+		}
+	}
+	return
+}
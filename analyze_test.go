@@ -0,0 +1,33 @@
+package root_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestAnalyze(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return -(x - 1) * (x - 3), nil
+	}
+	roots, minima, maxima, err := root.Analyze(f, 0, 4, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("roots: %v, minima: %v, maxima: %v", roots, minima, maxima)
+	if len(roots) != 2 || 1e-5 < math.Abs(roots[0]-1) || 1e-5 < math.Abs(roots[1]-3) {
+		t.Errorf("not valid roots: %v", roots)
+	}
+	if len(minima) != 0 {
+		t.Errorf("not valid minima: %v", minima)
+	}
+	if len(maxima) != 1 || 1e-5 < math.Abs(maxima[0]-2) {
+		t.Errorf("not valid maxima: %v", maxima)
+	}
+	t.Run("not valid", func(t *testing.T) {
+		if _, _, _, err := root.Analyze(f, 0, 4, 1); err == nil {
+			t.Errorf("one panel is accepted")
+		}
+	})
+}
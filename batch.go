@@ -0,0 +1,93 @@
+package root
+
+import (
+	"fmt"
+	"sync"
+)
+
+// FindBatch finds root of function on each interval. Error of each
+// interval is independent.
+//
+//	Input data:
+//		f        - function of variable X for root-finding
+//		brackets - intervals [minX, maxX] of root-finding
+//	Output data:
+//		roots    - roots of function for each interval
+//		errs     - errors for each interval
+//
+// Notes:
+//   - Concurrency acceptable
+//   - Panic-free function
+func FindBatch[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), brackets [][2]F64) (roots []F64, errs []error) {
+	roots = make([]F64, len(brackets))
+	errs = make([]error, len(brackets))
+	cfg := Config{}
+	for i, b := range brackets {
+		roots[i], errs[i] = find(cfg, f, b[0], b[1], nil)
+	}
+	return
+}
+
+// FindParallel is same as FindBatch, but intervals are distributed
+// between workers, which run concurrently. Function must be safe for
+// concurrent calls. Roots and errors are in order of intervals.
+//
+//	Input data:
+//		f        - function of variable X for root-finding
+//		brackets - intervals [minX, maxX] of root-finding
+//		workers  - amount of concurrent workers
+//	Output data:
+//		roots    - roots of function for each interval
+//		errs     - errors for each interval
+//
+// If amount of workers is less 1, then returns ErrorFind with type
+// NotValidValue for each interval.
+//
+// Notes:
+//   - Concurrency acceptable
+//   - Panic-free function
+func FindParallel[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), brackets [][2]F64, workers int) (roots []F64, errs []error) {
+	roots = make([]F64, len(brackets))
+	errs = make([]error, len(brackets))
+	if workers < 1 {
+		for i := range errs {
+			errs[i] = ErrorFind{
+				Type: NotValidValue,
+				Err:  fmt.Errorf("amount of workers is not valid: %d", workers),
+			}
+		}
+		return
+	}
+	var (
+		cfg  = Config{}
+		jobs = make(chan int)
+		wg   sync.WaitGroup
+	)
+	run := func(i int) {
+		// recovering
+		defer func() {
+			if r := recover(); r != nil {
+				errs[i] = ErrorFind{
+					Type: Recovery,
+					Err:  fmt.Errorf("%#v", r),
+				}
+			}
+		}()
+		roots[i], errs[i] = find(cfg, f, brackets[i][0], brackets[i][1], nil)
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				run(i)
+			}
+		}()
+	}
+	for i := range brackets {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return
+}
@@ -0,0 +1,77 @@
+package root_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindBatch(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		if x < -10 {
+			panic("out of domain")
+		}
+		return math.Sin(x), nil
+	}
+	brackets := [][2]float64{{3, 4}, {-1, 0.5}, {1, 2}, {-20, -15}, {6, 7}}
+	roots, errs := root.FindBatch(f, brackets)
+	if len(roots) != len(brackets) || len(errs) != len(brackets) {
+		t.Fatalf("not valid amount of results: %d %d", len(roots), len(errs))
+	}
+	for i, expect := range []float64{math.Pi, 0, math.NaN(), math.NaN(), 2 * math.Pi} {
+		if math.IsNaN(expect) {
+			t.Logf("%v", errs[i])
+			if errs[i] == nil {
+				t.Errorf("bracket %v: error is not found", brackets[i])
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Errorf("bracket %v: %v", brackets[i], errs[i])
+			continue
+		}
+		if 1e-5 < math.Abs(roots[i]-expect) {
+			t.Errorf("bracket %v: not valid root %e", brackets[i], roots[i])
+		}
+	}
+	var ef root.ErrorFind
+	if !errors.As(errs[3], &ef) || ef.Type != root.Recovery {
+		t.Errorf("panic is not recovered: %v", errs[3])
+	}
+}
+
+func TestFindParallel(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		if x < -10 {
+			panic("out of domain")
+		}
+		return math.Sin(x), nil
+	}
+	var brackets [][2]float64
+	for i := 1; i <= 20; i++ {
+		x := float64(i) * math.Pi
+		brackets = append(brackets, [2]float64{x - 1, x + 0.5})
+	}
+	brackets = append(brackets, [2]float64{-20, -15})
+	expectRoots, expectErrs := root.FindBatch(f, brackets)
+	for _, workers := range []int{1, 3, 100} {
+		roots, errs := root.FindParallel(f, brackets, workers)
+		for i := range brackets {
+			if roots[i] != expectRoots[i] || (errs[i] == nil) != (expectErrs[i] == nil) {
+				t.Errorf("workers %d, bracket %v: not same result: %e %v",
+					workers, brackets[i], roots[i], errs[i])
+			}
+		}
+	}
+	t.Run("workers", func(t *testing.T) {
+		_, errs := root.FindParallel(f, brackets, 0)
+		for i := range errs {
+			var ef root.ErrorFind
+			if !errors.As(errs[i], &ef) || ef.Type != root.NotValidValue {
+				t.Fatalf("not valid error: %v", errs[i])
+			}
+		}
+	})
+}
@@ -0,0 +1,327 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// MaxAbs is max allowable absolute value of X for expansion of
+// interval in WidenBracket, FindExpand and FindExponential.
+// Use SetMaxAbs for change concurrently with root-finding.
+var MaxAbs float64 = math.Inf(1)
+
+// SetMaxAbs sets package variable MaxAbs. Function is safe for calls
+// concurrently with root-finding.
+func SetMaxAbs(maxAbs float64) error {
+	if !(0 < maxAbs) {
+		return ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("max absolute value is not valid: %s", fmtFloat(maxAbs)),
+		}
+	}
+	settings.Lock()
+	MaxAbs = maxAbs
+	settings.Unlock()
+	return nil
+}
+
+// outside returns error with searched interval [lo, hi], if absolute
+// value of next X is more MaxAbs
+func outside(x, lo, hi float64) error {
+	settings.RLock()
+	maxAbs := MaxAbs
+	settings.RUnlock()
+	if math.Abs(x) <= maxAbs {
+		return nil
+	}
+	return ErrorFind{
+		Type: MaximalIteration,
+		Err: fmt.Errorf("%w: [%s, %s] and limit MaxAbs %s",
+			ErrNoSignChange, fmtFloat(lo), fmtFloat(hi), fmtFloat(maxAbs)),
+	}
+}
+
+// WidenBracket symmetrically expands the interval [lo, hi] around its
+// center until the function changes sign on the interval borders.
+// On each try the half-width of the interval is multiplied by factor.
+// Unlike a search from a single point, the center of the interval is
+// preserved.
+//
+//	Input data:
+//		f        - function of variable X
+//		lo       - minimal X of initial interval
+//		hi       - maximal X of initial interval
+//		factor   - expansion factor, must be more 1
+//		maxTries - max allowable amount of expansions
+//	Output data:
+//		lo, hi   - interval with sign change
+//		err      - error if some is not ok
+//
+// If sign change is not found or interval is outside of MaxAbs,
+// then returns ErrorFind with wrapped ErrNoSignChange.
+//
+// Notes:
+//   - Panic-free function
+func WidenBracket(f func(float64) (float64, error), lo, hi float64, factor float64, maxTries int) (_, _ float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	// check input data
+	if !(1 < factor) || math.IsInf(factor, 0) {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("factor is not valid: %s", fmtFloat(factor)),
+		}
+		return
+	}
+	if maxTries < 1 {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("amount of tries is not valid: %d", maxTries),
+		}
+		return
+	}
+	for _, v := range []float64{lo, hi} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			err = ErrorFind{
+				Type: NotValidValue,
+				Err:  fmt.Errorf("border is not valid: %s", fmtFloat(v)),
+			}
+			return
+		}
+	}
+	// replace borders
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	if lo == hi {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("interval have zero width: %s", fmtFloat(lo)),
+		}
+		return
+	}
+	var (
+		center = lo + (hi-lo)/2.0
+		half   = (hi - lo) / 2.0
+	)
+	for try := 0; ; try++ {
+		var yLo, yHi float64
+		if yLo, err = f(lo); err != nil {
+			return
+		}
+		if yHi, err = f(hi); err != nil {
+			return
+		}
+		if yLo == 0 || yHi == 0 || math.Signbit(yLo) != math.Signbit(yHi) {
+			return lo, hi, nil
+		}
+		if try >= maxTries {
+			err = ErrorFind{
+				Type: MaximalIteration,
+				Err: fmt.Errorf("%w: [%s, %s] after %d tries",
+					ErrNoSignChange, fmtFloat(lo), fmtFloat(hi), try),
+			}
+			return
+		}
+		// expand interval
+		half *= factor
+		if err = outside(math.Max(math.Abs(center-half), math.Abs(center+half)), lo, hi); err != nil {
+			return
+		}
+		lo, hi = center-half, center+half
+	}
+}
+
+// maxDoublings is max allowable amount of doublings in FindExponential
+const maxDoublings = 100
+
+// FindExponential finds root of monotone function on interval
+// [start, +Inf) by exponential search. Upper border of interval is
+// doubled until the function changes sign, then the last doubling
+// interval is bisected by Find.
+//
+//	Input data:
+//		f     - monotone function of variable X for root-finding
+//		start - minimal X
+//	Output data:
+//		root  - root of function
+//		err   - error if some is not ok
+//
+// If sign change is not found after 100 doublings or interval is
+// outside of MaxAbs, then returns ErrorFind with wrapped ErrNoSignChange.
+//
+// Notes:
+//   - Panic-free function
+func FindExponential(f func(float64) (float64, error), start float64) (root float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	if math.IsNaN(start) || math.IsInf(start, 0) {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("start is not valid: %s", fmtFloat(start)),
+		}
+		return
+	}
+	var (
+		lo, hi = start, start
+		width  = math.Max(math.Abs(start), 1.0)
+		yLo    float64
+		yHi    float64
+	)
+	if yLo, err = f(lo); err != nil {
+		return
+	}
+	if prec, _ := parameters(); math.Abs(yLo) < prec {
+		// find the solution
+		root = lo
+		_, err = f(root)
+		return
+	}
+	for doubling := 0; ; doubling++ {
+		if doubling >= maxDoublings || math.IsInf(lo+width, 0) {
+			err = ErrorFind{
+				Type: MaximalIteration,
+				Err: fmt.Errorf("%w: [%s, %s] after %d doublings",
+					ErrNoSignChange, fmtFloat(start), fmtFloat(hi), doubling),
+			}
+			return
+		}
+		if err = outside(lo+width, start, hi); err != nil {
+			return
+		}
+		hi = lo + width
+		if yHi, err = f(hi); err != nil {
+			return
+		}
+		if yHi == 0 || math.Signbit(yLo) != math.Signbit(yHi) {
+			break
+		}
+		lo, yLo = hi, yHi
+		width *= 2
+	}
+	return Find(f, lo, hi)
+}
+
+// FindExpand finds root of function, when the function may have not
+// changed sign on initial interval [x0, x1]. On each try the border with
+// smaller absolute value of function is moved outward by factor of
+// width of interval, then the interval with sign change is bisected
+// by Find.
+//
+// Documentation: https://www.gnu.org/software/gsl/doc/html/roots.html
+//
+//	Input data:
+//		f         - function of variable X for root-finding
+//		x0        - first border of initial interval
+//		x1        - second border of initial interval
+//		factor    - expansion factor, must be more 0
+//		maxExpand - max allowable amount of expansions
+//	Output data:
+//		root      - root of function
+//		err       - error if some is not ok
+//
+// If sign change is not found or interval is outside of MaxAbs,
+// then returns ErrorFind with wrapped ErrNoSignChange.
+//
+// Notes:
+//   - Panic-free function
+func FindExpand(f func(float64) (float64, error), x0, x1 float64, factor float64, maxExpand int) (root float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	// check input data
+	if !(0 < factor) || math.IsInf(factor, 0) {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("factor is not valid: %s", fmtFloat(factor)),
+		}
+		return
+	}
+	if maxExpand < 0 {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("amount of expansions is not valid: %d", maxExpand),
+		}
+		return
+	}
+	for _, v := range []float64{x0, x1} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			err = ErrorFind{
+				Type: NotValidValue,
+				Err:  fmt.Errorf("border is not valid: %s", fmtFloat(v)),
+			}
+			return
+		}
+	}
+	// replace borders
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	if x0 == x1 {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("interval have zero width: %s", fmtFloat(x0)),
+		}
+		return
+	}
+	var y0, y1 float64
+	if y0, err = f(x0); err != nil {
+		return
+	}
+	if y1, err = f(x1); err != nil {
+		return
+	}
+	for expand := 0; ; expand++ {
+		if y0 == 0 || y1 == 0 || math.Signbit(y0) != math.Signbit(y1) {
+			break
+		}
+		if expand >= maxExpand {
+			err = ErrorFind{
+				Type: MaximalIteration,
+				Err: fmt.Errorf("%w: [%s, %s] after %d expansions",
+					ErrNoSignChange, fmtFloat(x0), fmtFloat(x1), expand),
+			}
+			return
+		}
+		// expand interval
+		width := factor * (x1 - x0)
+		if math.Abs(y0) < math.Abs(y1) {
+			if err = outside(x0-width, x0, x1); err != nil {
+				return
+			}
+			x0 -= width
+			if y0, err = f(x0); err != nil {
+				return
+			}
+		} else {
+			if err = outside(x1+width, x0, x1); err != nil {
+				return
+			}
+			x1 += width
+			if y1, err = f(x1); err != nil {
+				return
+			}
+		}
+	}
+	return Find(f, x0, x1)
+}
@@ -0,0 +1,212 @@
+package root_test
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestWidenBracket(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return x - 1.0, nil
+	}
+	t.Run("narrow", func(t *testing.T) {
+		// bracket just missing the root
+		lo, hi, err := root.WidenBracket(f, 0.2, 0.8, 2, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !(lo < 1.0 && 1.0 < hi) {
+			t.Errorf("root is not bracketed: [%e, %e]", lo, hi)
+		}
+		if center := lo + (hi-lo)/2; center != 0.5 {
+			t.Errorf("center is not preserved: %e", center)
+		}
+		rootX, err := root.Find(f, lo, hi)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root.Precision < math.Abs(rootX-1.0) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("valid", func(t *testing.T) {
+		lo, hi, err := root.WidenBracket(f, 0, 2, 2, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lo != 0 || hi != 2 {
+			t.Errorf("bracket is changed: [%e, %e]", lo, hi)
+		}
+	})
+	t.Run("tries", func(t *testing.T) {
+		_, _, err := root.WidenBracket(f, 100, 101, 1.1, 3)
+		t.Logf("%v", err)
+		if !errors.Is(err, root.ErrNoSignChange) {
+			t.Fatalf("not valid error: %v", err)
+		}
+	})
+	t.Run("factor", func(t *testing.T) {
+		_, _, err := root.WidenBracket(f, 0.2, 0.8, 0.5, 10)
+		t.Logf("%v", err)
+		if err == nil {
+			t.Fatalf("not valid factor is accepted")
+		}
+	})
+}
+
+func TestFindExponential(t *testing.T) {
+	t.Run("far root", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return math.Sqrt(x) - math.Sqrt(1000), nil
+		}
+		rootX, err := root.FindExponential(f, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-6 < math.Abs(rootX-1000)/1000 {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("zero start", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return x - 1000, nil
+		}
+		rootX, err := root.FindExponential(f, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-6 < math.Abs(rootX-1000)/1000 {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("no root", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return math.Atan(x) - 2, nil
+		}
+		_, err := root.FindExponential(f, 1)
+		t.Logf("%v", err)
+		if !errors.Is(err, root.ErrNoSignChange) {
+			t.Fatalf("not valid error: %v", err)
+		}
+	})
+}
+
+func TestFindExpand(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return math.Log(x) - 2, nil
+	}
+	t.Run("expand", func(t *testing.T) {
+		rootX, err := root.FindExpand(f, 1, 2, 1.6, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if y, _ := f(rootX); root.Precision < math.Abs(y) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("left", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return -x - 20, nil
+		}
+		rootX, err := root.FindExpand(g, 0, 1, 1.6, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root.Precision < math.Abs(rootX+20) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("expansions", func(t *testing.T) {
+		_, err := root.FindExpand(f, 1, 2, 1.6, 1)
+		t.Logf("%v", err)
+		if !errors.Is(err, root.ErrNoSignChange) {
+			t.Fatalf("not valid error: %v", err)
+		}
+	})
+	t.Run("factor", func(t *testing.T) {
+		if _, err := root.FindExpand(f, 1, 2, 0, 10); err == nil {
+			t.Fatalf("not valid factor is accepted")
+		}
+	})
+}
+
+func TestMaxAbs(t *testing.T) {
+	defer func(m float64) {
+		root.MaxAbs = m
+	}(root.MaxAbs)
+	if err := root.SetMaxAbs(1e3); err != nil {
+		t.Fatal(err)
+	}
+
+	var xMax float64
+	f := func(x float64) (float64, error) {
+		xMax = math.Max(xMax, math.Abs(x))
+		return math.Exp(x) + 1, nil
+	}
+	t.Run("WidenBracket", func(t *testing.T) {
+		xMax = 0
+		_, _, err := root.WidenBracket(f, 0, 1, 2, 1000000)
+		t.Logf("%v", err)
+		if !errors.Is(err, root.ErrNoSignChange) {
+			t.Errorf("not valid error: %v", err)
+		}
+		if root.MaxAbs < xMax {
+			t.Errorf("function is run outside of MaxAbs: %e", xMax)
+		}
+	})
+	t.Run("FindExpand", func(t *testing.T) {
+		xMax = 0
+		_, err := root.FindExpand(f, 0, 1, 1.6, 1000000)
+		t.Logf("%v", err)
+		if !errors.Is(err, root.ErrNoSignChange) {
+			t.Errorf("not valid error: %v", err)
+		}
+		if root.MaxAbs < xMax {
+			t.Errorf("function is run outside of MaxAbs: %e", xMax)
+		}
+	})
+	t.Run("FindExponential", func(t *testing.T) {
+		xMax = 0
+		_, err := root.FindExponential(f, 0)
+		t.Logf("%v", err)
+		if !errors.Is(err, root.ErrNoSignChange) {
+			t.Errorf("not valid error: %v", err)
+		}
+		if root.MaxAbs < xMax {
+			t.Errorf("function is run outside of MaxAbs: %e", xMax)
+		}
+	})
+	t.Run("concurrent", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return math.Exp(x) + 1, nil
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					if _, err := root.FindExponential(g, 0); err == nil {
+						t.Error("sign change is found")
+						return
+					}
+				}
+			}()
+		}
+		for j := 0; j < 100; j++ {
+			if err := root.SetMaxAbs(1e3 * float64(1+j%3)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		wg.Wait()
+	})
+	for _, m := range []float64{0, -1, math.NaN()} {
+		if err := root.SetMaxAbs(m); err == nil {
+			t.Errorf("not valid max absolute value is accepted: %e", m)
+		}
+	}
+}
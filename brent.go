@@ -0,0 +1,169 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// FindBrent finds root of function by Brent's method. Method combines
+// inverse quadratic interpolation, secant method and bisection, so
+// the root is always bracketed and the convergence is typically faster
+// than bisection.
+//
+// Documentation: https://en.wikipedia.org/wiki/Brent%27s_method
+//
+//	Input data:
+//		f    - function of variable X for root-finding
+//		minX - minimal X
+//		maxX - maximal X
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// Convergence is checked same as in Find. If interval cannot be splitted
+// in float64 arithmetic, but absolute value of function is not less
+// Precision, then returns ErrorFind with type Discontinuity.
+//
+// Notes:
+//   - Panic-free function
+func FindBrent(f func(float64) (float64, error), minX, maxX float64) (root float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	var (
+		prec, maxIter = parameters()
+
+		a, b, c    = minX, maxX, minX
+		fa, fb, fc float64
+		d, e       = maxX - minX, maxX - minX
+	)
+	if err = checkPrecision(prec); err != nil {
+		return
+	}
+	if fa, err = f(a); err != nil {
+		return
+	}
+	if fb, err = f(b); err != nil {
+		return
+	}
+	if err = checkValues(a, fa); err != nil {
+		return
+	}
+	if err = checkValues(b, fb); err != nil {
+		return
+	}
+	if math.Abs(fa) < prec {
+		return a, nil
+	}
+	if math.Abs(fb) < prec {
+		return b, nil
+	}
+	if math.Signbit(fa) == math.Signbit(fb) {
+		err = ErrorFind{
+			Type: InternalErr,
+			Err: fmt.Errorf("%w: [%s, %s]",
+				ErrNoSignChange, fmtFloat(fa), fmtFloat(fb)),
+		}
+		return
+	}
+	fc = fa
+	for iter := 0; ; iter++ {
+		if iter >= maxIter {
+			err = ErrorFind{
+				Type: MaximalIteration,
+				Err:  fmt.Errorf("Too many iterations: %d", iter),
+			}
+			return
+		}
+		if fb != 0 && math.Signbit(fb) == math.Signbit(fc) {
+			// root is between a and b
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			// b is the best approximation
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		var (
+			tol = prec
+			xm  = (c - b) / 2.0
+		)
+		if b != 0 {
+			tol = prec * math.Abs(b)
+		}
+		tol = math.Max(tol/2.0, MinInterval(b))
+		if fb == 0 || (math.Abs(xm) <= tol && math.Abs(fb) < prec) {
+			break // find the solution
+		}
+		if math.Abs(c-b) <= math.Max(MinInterval(b), MinInterval(c)) {
+			// interval cannot be splitted in float64 arithmetic
+			if prec <= math.Abs(fb) {
+				root = b
+				err = ErrorFind{
+					Type: Discontinuity,
+					Err: fmt.Errorf("sign change without root: |f(%s)| = %s",
+						fmtFloat(b), fmtFloat(math.Abs(fb))),
+				}
+				return
+			}
+			break
+		}
+		if tol < math.Abs(xm) && tol <= math.Abs(e) && math.Abs(fb) < math.Abs(fa) {
+			// interpolation
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				// secant method
+				p = 2.0 * xm * s
+				q = 1.0 - s
+			} else {
+				// inverse quadratic interpolation
+				q = fa / fc
+				r := fb / fc
+				p = s * (2.0*xm*q*(q-r) - (b-a)*(r-1.0))
+				q = (q - 1.0) * (r - 1.0) * (s - 1.0)
+			}
+			if 0 < p {
+				q = -q
+			}
+			p = math.Abs(p)
+			if 2.0*p < math.Min(3.0*xm*q-math.Abs(tol*q), math.Abs(e*q)) {
+				// interpolation is accepted
+				e = d
+				d = p / q
+			} else {
+				// bisection
+				d = xm
+				e = d
+			}
+		} else {
+			// bisection
+			d = xm
+			e = d
+		}
+		a, fa = b, fb
+		if tol < math.Abs(d) || math.Abs(xm) <= tol {
+			// interval less tolerance is bisected, if residual is
+			// not achieved
+			b += d
+		} else {
+			b += math.Copysign(tol, xm)
+		}
+		if fb, err = f(b); err != nil {
+			return
+		}
+		if err = checkValues(b, fb); err != nil {
+			return
+		}
+	}
+	root = b
+	return
+}
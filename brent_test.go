@@ -0,0 +1,109 @@
+package root_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindBrent(t *testing.T) {
+	var brent, bisection int
+	for i := range tcs {
+		t.Run(fmt.Sprintf("Case%3d", i), func(t *testing.T) {
+			f := func(x float64) (float64, error) {
+				brent++
+				return tcs[i].f(x), nil
+			}
+			rootX, err := root.FindBrent(f, tcs[i].Xmin, tcs[i].Xmax)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rootX < tcs[i].Xmin || tcs[i].Xmax < rootX {
+				t.Errorf("not valid root")
+			}
+			if root.Precision < math.Abs(tcs[i].f(rootX)) {
+				t.Errorf("not valid precision: %e", math.Abs(tcs[i].f(rootX)))
+			}
+			res, err := root.FindResult(fixtureFunc(tcs[i].f), tcs[i].Xmin, tcs[i].Xmax)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bisection += res.Evaluations
+		})
+	}
+	t.Logf("Average amount of calls: Brent %.2f, bisection %.2f",
+		float64(brent)/float64(len(tcs)), float64(bisection)/float64(len(tcs)))
+	if bisection <= brent {
+		t.Errorf("Brent's method is not faster")
+	}
+	t.Run("NaN", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			if 0.5 < x {
+				return math.NaN(), nil
+			}
+			return x - 0.7, nil
+		}
+		_, err := root.FindBrent(f, 0, 0.8)
+		t.Logf("%v", err)
+		if err == nil {
+			t.Errorf("NaN is not detected")
+		}
+	})
+	t.Run("jump", func(t *testing.T) {
+		jump := func(x float64) (float64, error) {
+			if x < 0.3 {
+				return -1, nil
+			}
+			return 1, nil
+		}
+		for _, b := range [][2]float64{{0, 1}, {1, 0}} {
+			var calls int
+			g := func(x float64) (float64, error) {
+				calls++
+				return jump(x)
+			}
+			rootX, err := root.FindBrent(g, b[0], b[1])
+			t.Logf("%e %v, calls %d", rootX, err, calls)
+			var ef root.ErrorFind
+			if !errors.As(err, &ef) || ef.Type != root.Discontinuity {
+				t.Errorf("not valid error: %v", err)
+			}
+			if 1e-5 < math.Abs(rootX-0.3) {
+				t.Errorf("not valid jump: %e", rootX)
+			}
+			if root.MaxIteration <= calls {
+				t.Errorf("too many calls: %d", calls)
+			}
+		}
+	})
+}
+
+func fixtureFunc(f func(float64) float64) func(float64) (float64, error) {
+	return func(x float64) (float64, error) {
+		return f(x), nil
+	}
+}
+
+func BenchmarkFindBrent(b *testing.B) {
+	for _, name := range []string{"Find", "FindBrent"} {
+		solve := root.Find[float64, float64]
+		if name == "FindBrent" {
+			solve = root.FindBrent
+		}
+		b.Run(name, func(b *testing.B) {
+			var calls int
+			for n := 0; n < b.N; n++ {
+				for i := range tcs {
+					_, _ = solve(func(x float64) (float64, error) {
+						calls++
+						return tcs[i].f(x), nil
+					}, tcs[i].Xmin, tcs[i].Xmax)
+				}
+			}
+			b.ReportMetric(float64(calls)/float64(b.N*len(tcs)), "calls/op")
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package root
+
+import "math"
+
+// methods is list of all methods of root-finding
+var methods = []Method{
+	Bisection,
+	FalsePosition,
+	Illinois,
+	AndersonBjorck,
+}
+
+// solvers is list of bracketing root-finding functions, which are not
+// methods of Find
+var solvers = map[string]SolveFunc{
+	"Brent":   FindBrent,
+	"Ridders": FindRidders,
+}
+
+// CompareMethods runs root-finding by each method and returns result
+// of each method. Error of root-finding is stored in Result.Err.
+func CompareMethods(f func(float64) (float64, error), minX, maxX float64) map[Method]Result {
+	results := make(map[Method]Result, len(methods))
+	for _, m := range methods {
+		results[m], _ = findResult(Config{Method: m}, f, minX, maxX)
+	}
+	return results
+}
+
+// CompareSolvers is same as CompareMethods, but also runs FindBrent and
+// FindRidders. Results are stored by name of method or name of solver.
+// Field Duration of result is wall-clock time of searching, so all
+// bracketing methods can be compared by time.
+//
+// For FindBrent and FindRidders only fields Root, Evaluations,
+// DuplicateEvaluations, Err, Duration and FinalResidual are calculated.
+func CompareSolvers(f func(float64) (float64, error), minX, maxX float64) map[string]Result {
+	results := make(map[string]Result, len(methods)+len(solvers))
+	for m, res := range CompareMethods(f, minX, maxX) {
+		results[m.String()] = res
+	}
+	for name, solve := range solvers {
+		results[name] = solverResult(solve, f, minX, maxX)
+	}
+	return results
+}
+
+// solverResult runs solver with statistics of function calls
+func solverResult(solve SolveFunc, f func(float64) (float64, error), minX, maxX float64) Result {
+	res, _ := statistics(Config{}, f, func(g func(float64) (float64, error), _ *Result) (float64, error) {
+		return solve(g, minX, maxX)
+	})
+	res.EstimatedOrder = math.NaN()
+	return res
+}
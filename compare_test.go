@@ -0,0 +1,63 @@
+package root_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestCompareMethods(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return math.Exp(x) - math.Exp(-x) - 2, nil
+	}
+	results := root.CompareMethods(f, 0, 1)
+	if len(results) < 4 {
+		t.Fatalf("not enough methods: %d", len(results))
+	}
+	expect := math.Asinh(1)
+	for m, res := range results {
+		t.Logf("%-16s: root = %.8f, iterations = %3d, evaluations = %3d, duration = %v",
+			m, res.Root, res.Iterations, res.Evaluations, res.Duration)
+		if res.Duration <= 0 {
+			t.Errorf("%s: duration is not measured", m)
+		}
+		if res.Err != nil {
+			t.Errorf("%s: %v", m, res.Err)
+			continue
+		}
+		if 1e-5 < math.Abs(res.Root-expect) {
+			t.Errorf("%s: not valid root %e", m, res.Root)
+		}
+	}
+}
+
+func TestCompareSolvers(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return math.Exp(x) - math.Exp(-x) - 2, nil
+	}
+	results := root.CompareSolvers(f, 0, 1)
+	expect := math.Asinh(1)
+	for _, name := range []string{"bisection", "false position", "Illinois", "Anderson-Bjorck", "Brent", "Ridders"} {
+		res, ok := results[name]
+		if !ok {
+			t.Errorf("%s: result is not found", name)
+			continue
+		}
+		t.Logf("%-16s: root = %.8f, evaluations = %3d, duration = %v",
+			name, res.Root, res.Evaluations, res.Duration)
+		if res.Err != nil {
+			t.Errorf("%s: %v", name, res.Err)
+			continue
+		}
+		if 1e-5 < math.Abs(res.Root-expect) {
+			t.Errorf("%s: not valid root %e", name, res.Root)
+		}
+		if res.Evaluations == 0 || res.Duration <= 0 {
+			t.Errorf("%s: statistics is not calculated", name)
+		}
+		if !(res.FinalResidual < root.Precision) {
+			t.Errorf("%s: not valid final residual: %e", name, res.FinalResidual)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package root
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+// complexStep is relative step of finite difference in FindComplex
+const complexStep = 1e-7
+
+// FindComplex finds root of analytic function of complex variable by
+// Newton's method in the complex plane. Derivative is calculated by
+// central finite difference along the real axis, that is valid for
+// analytic function:
+//
+//	f'(z) = (f(z+h)-f(z-h)) / (2*h)
+//
+// Documentation: https://en.wikipedia.org/wiki/Newton%27s_method#Complex_functions
+//
+//	Input data:
+//		f    - analytic function of complex variable
+//		z0   - initial Z
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// For real function with real initial Z the iterations are on the
+// real axis, so for complex roots the initial Z must be complex.
+// Convergence is checked same as in FindDampedNewton with absolute
+// values of complex numbers.
+//
+// Notes:
+//   - Panic-free function
+func FindComplex(f func(complex128) (complex128, error), z0 complex128) (root complex128, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	var (
+		prec, maxIter = parameters()
+		z, y          = z0, complex128(0)
+	)
+	if err = checkPrecision(prec); err != nil {
+		return
+	}
+	if y, err = f(z); err != nil {
+		return
+	}
+	if err = checkComplex(z, y); err != nil {
+		return
+	}
+	for iter := 0; ; iter++ {
+		if y == 0 {
+			break // exact root
+		}
+		if iter >= maxIter {
+			err = ErrorFind{
+				Type: MaximalIteration,
+				Err:  fmt.Errorf("Too many iterations: %d", iter),
+			}
+			return
+		}
+		var (
+			h      = complex(complexStep*math.Max(1, cmplx.Abs(z)), 0)
+			yl, yr complex128
+		)
+		if yl, err = f(z - h); err != nil {
+			return
+		}
+		if yr, err = f(z + h); err != nil {
+			return
+		}
+		d := (yr - yl) / (2 * h)
+		if d == 0 || cmplx.IsNaN(d) || cmplx.IsInf(d) {
+			err = ErrorFind{
+				Type: InternalErr,
+				Err:  fmt.Errorf("not valid derivative %v at %v", d, z),
+			}
+			return
+		}
+		step := -y / d
+		z += step
+		if y, err = f(z); err != nil {
+			return
+		}
+		if err = checkComplex(z, y); err != nil {
+			return
+		}
+		if cmplx.Abs(y) < prec && converged(cmplx.Abs(step), cmplx.Abs(z), prec) {
+			break // find the solution
+		}
+	}
+	root = z
+	return
+}
+
+// checkComplex returns error for NaN and Inf values
+func checkComplex(z, y complex128) error {
+	if cmplx.IsNaN(z) {
+		return ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("z is NaN"),
+		}
+	}
+	if cmplx.IsNaN(y) {
+		return ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("y is NaN"),
+		}
+	}
+	if cmplx.IsInf(z) {
+		return ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("z is Inf"),
+		}
+	}
+	if cmplx.IsInf(y) {
+		return ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("y is Inf"),
+		}
+	}
+	return nil
+}
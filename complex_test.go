@@ -0,0 +1,66 @@
+package root_test
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindComplex(t *testing.T) {
+	t.Run("quadratic", func(t *testing.T) {
+		// roots are 1 +- 2i
+		f := func(z complex128) (complex128, error) {
+			return z*z - 2*z + 5, nil
+		}
+		for _, tc := range []struct {
+			z0, root complex128
+		}{
+			{complex(0, 1), complex(1, 2)},
+			{complex(0, -1), complex(1, -2)},
+		} {
+			rootZ, err := root.FindComplex(f, tc.z0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if 1e-6 < cmplx.Abs(rootZ-tc.root) {
+				t.Errorf("not valid root: %v != %v", rootZ, tc.root)
+			}
+		}
+	})
+	t.Run("exponential", func(t *testing.T) {
+		f := func(z complex128) (complex128, error) {
+			return cmplx.Exp(z) + 1, nil
+		}
+		rootZ, err := root.FindComplex(f, complex(0.5, 3))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-6 < cmplx.Abs(rootZ-complex(0, math.Pi)) {
+			t.Errorf("not valid root: %v", rootZ)
+		}
+	})
+	t.Run("real axis", func(t *testing.T) {
+		// complex roots are not reached from real initial Z
+		f := func(z complex128) (complex128, error) {
+			return z*z + 1, nil
+		}
+		_, err := root.FindComplex(f, 2)
+		t.Logf("%v", err)
+		if err == nil {
+			t.Fatalf("root is found")
+		}
+	})
+	t.Run("NaN", func(t *testing.T) {
+		f := func(z complex128) (complex128, error) {
+			return cmplx.NaN(), nil
+		}
+		_, err := root.FindComplex(f, 1)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.NotValidValue {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,111 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// constrainedDepth is max depth of subdivision for interval without
+// sign change in FindConstrained
+const constrainedDepth = 6
+
+// FindConstrained finds root of function, which satisfies the constraint.
+//
+// Searching strategy:
+//   - root is found by Find on interval [minX, maxX];
+//   - if constraint is satisfied, then root is returned;
+//   - if not, then the final interval of searching is excluded and
+//     searching is continued on left part of interval and after that
+//     on right part of interval;
+//   - if root is on the border of interval, then the interval is halved
+//     and searching is continued on each half;
+//   - part without sign change on borders is halved for detection of
+//     hidden sign changes, but not more 6 times in depth.
+//
+// So, the first from left root, which satisfies the constraint and found
+// by that strategy, is returned. If root is not found, then returns
+// ErrorFind with type NotValidValue.
+//
+// Notes:
+//   - Panic-free function
+func FindConstrained[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), constraint func(F64) (bool, error), minX, maxX F64) (root F64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	// replace borders
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	var yMin, yMax F64R
+	if yMin, err = f(minX); err != nil {
+		return
+	}
+	if yMax, err = f(maxX); err != nil {
+		return
+	}
+	var search func(x0 F64, y0 F64R, x1 F64, y1 F64R, depth int) (found bool, err error)
+	// halve runs searching on both halves of interval
+	halve := func(x0 F64, y0 F64R, x1 F64, y1 F64R, depth int) (found bool, err error) {
+		if depth <= 0 {
+			return
+		}
+		xm := x0 + (x1-x0)/2.0
+		if xm == x0 || xm == x1 {
+			return
+		}
+		var ym F64R
+		if ym, err = f(xm); err != nil {
+			return
+		}
+		if found, err = search(x0, y0, xm, ym, depth-1); found || err != nil {
+			return
+		}
+		return search(xm, ym, x1, y1, depth-1)
+	}
+	search = func(x0 F64, y0 F64R, x1 F64, y1 F64R, depth int) (found bool, err error) {
+		if !(x0 < x1) {
+			return
+		}
+		if math.Signbit(float64(y0)) == math.Signbit(float64(y1)) {
+			// no sign change
+			return halve(x0, y0, x1, y1, depth)
+		}
+		var res Result
+		// final interval is excluded, so it must be narrow
+		if root, err = find(Config{bisectOnly: true}, f, x0, x1, &res); err != nil {
+			return
+		}
+		if found, err = constraint(root); found || err != nil {
+			return
+		}
+		if res.AtEndpoint {
+			// final interval is the whole interval, so only the border
+			// is excluded by halving of interval
+			return halve(x0, y0, x1, y1, depth)
+		}
+		// exclude final interval of searching
+		if found, err = search(x0, y0, F64(res.Lower), F64R(res.yLeft), depth); found || err != nil {
+			return
+		}
+		return search(F64(res.Upper), F64R(res.yRigth), x1, y1, depth)
+	}
+	found, err := search(minX, yMin, maxX, yMax, constrainedDepth)
+	if err != nil {
+		return
+	}
+	if !found {
+		root = 0
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err: fmt.Errorf("root with constraint is not found on [%s, %s]",
+				fmtFloat(minX), fmtFloat(maxX)),
+		}
+	}
+	return
+}
@@ -0,0 +1,60 @@
+package root_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindConstrained(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return (x - 0.2) * (x - 0.5) * (x - 0.8), nil
+	}
+	t.Run("upper", func(t *testing.T) {
+		rootX, err := root.FindConstrained(f, func(x float64) (bool, error) {
+			return 0.6 < x, nil
+		}, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-5 < math.Abs(rootX-0.8) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("any", func(t *testing.T) {
+		rootX, err := root.FindConstrained(f, func(x float64) (bool, error) {
+			return true, nil
+		}, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if y, _ := f(rootX); root.Precision < math.Abs(y) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("endpoint", func(t *testing.T) {
+		// root on the left border is not satisfied the constraint
+		g := func(x float64) (float64, error) {
+			return x * (x - 0.5) * (x - 0.8), nil
+		}
+		rootX, err := root.FindConstrained(g, func(x float64) (bool, error) {
+			return 0.6 < x, nil
+		}, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-5 < math.Abs(rootX-0.8) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("none", func(t *testing.T) {
+		_, err := root.FindConstrained(f, func(x float64) (bool, error) {
+			return 1 < x, nil
+		}, 0, 1)
+		t.Logf("%v", err)
+		if err == nil {
+			t.Fatalf("root is found")
+		}
+	})
+}
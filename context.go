@@ -0,0 +1,42 @@
+package root
+
+import (
+	"context"
+	"errors"
+)
+
+// FindContext is same as Find, but searching is stopped, when the
+// context is done. Context is checked before each function call,
+// so the function is not called after cancellation.
+//
+//	Input data:
+//		ctx  - context of searching
+//		f    - function of variable X for root-finding
+//		minX - minimal X
+//		maxX - maximal X
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// If searching is stopped by the context, then returns ErrorFind with
+// type Cancelled and wrapped error of context. Other errors are returned
+// as is, even if the context is done after them.
+func FindContext[F64 ~float64, F64R ~float64](ctx context.Context, f func(F64) (F64R, error), minX, maxX F64) (root F64, err error) {
+	var errCtx error // error of context, that is stopped searching
+	g := func(x F64) (F64R, error) {
+		if errCtx = ctx.Err(); errCtx != nil {
+			return 0, errCtx
+		}
+		return f(x)
+	}
+	root, err = find(Config{}, g, minX, maxX, nil)
+	if err != nil && errCtx != nil {
+		// bounds of interval are kept in error
+		var ef ErrorFind
+		errors.As(err, &ef)
+		ef.Type = Cancelled
+		ef.Err = errCtx
+		err = ef
+	}
+	return
+}
@@ -0,0 +1,90 @@
+package root_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindContext(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return (x - 0.3) * (1 + x), nil
+	}
+	t.Run("background", func(t *testing.T) {
+		rootX, err := root.FindContext(context.Background(), f, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect, err := root.Find(f, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rootX != expect {
+			t.Errorf("not same root: %e != %e", rootX, expect)
+		}
+	})
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var calls int
+		g := func(x float64) (float64, error) {
+			calls++
+			if calls == 10 {
+				cancel()
+			}
+			return f(x)
+		}
+		_, err := root.FindContext(ctx, g, 0, 1)
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.Cancelled {
+			t.Fatalf("not valid error: %v", err)
+		}
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("context error is not unwrapped: %v", err)
+		}
+		if calls != 10 {
+			t.Errorf("function is called after cancellation: %d", calls)
+		}
+	})
+	t.Run("function error", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		errF := errors.New("function error")
+		var calls int
+		g := func(x float64) (float64, error) {
+			calls++
+			if calls == 10 {
+				// context is done after the error of function
+				cancel()
+				return 0, errF
+			}
+			return f(x)
+		}
+		_, err := root.FindContext(ctx, g, 0, 1)
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type == root.Cancelled {
+			t.Fatalf("not valid error: %v", err)
+		}
+		if !errors.Is(err, errF) {
+			t.Errorf("error of function is not unwrapped: %v", err)
+		}
+	})
+	t.Run("done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		var calls int
+		g := func(x float64) (float64, error) {
+			calls++
+			return math.Sin(x), nil
+		}
+		_, err := root.FindContext(ctx, g, -1, 2)
+		if !errors.Is(err, context.Canceled) || calls != 0 {
+			t.Errorf("not valid error: %v, calls %d", err, calls)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package root
+
+import "math"
+
+// estimateOrder returns estimation of convergence order by three
+// successive residuals. Result is NaN, if estimation is not possible.
+func estimateOrder(e0, e1, e2 float64) float64 {
+	e0, e1, e2 = math.Abs(e0), math.Abs(e1), math.Abs(e2)
+	if e0 == 0 || e1 == 0 || e2 == 0 || e0 == e1 {
+		return math.NaN()
+	}
+	return math.Log(e2/e1) / math.Log(e1/e0)
+}
+
+// ClassifyConvergence returns type of convergence by sequence of
+// residuals: "linear", "superlinear" or "quadratic".
+// Order of convergence is averaged by all three successive residuals:
+//
+//	order = log(e[k+1]/e[k]) / log(e[k]/e[k-1])
+//
+// Classification of order:
+//
+//	linear      : order < 1.25
+//	superlinear : order < 1.75
+//	quadratic   : other
+//
+// If order cannot be estimated, then returns "undefined".
+func ClassifyConvergence(residuals []float64) string {
+	var (
+		sum    float64
+		amount int
+	)
+	for i := 2; i < len(residuals); i++ {
+		order := estimateOrder(residuals[i-2], residuals[i-1], residuals[i])
+		if math.IsNaN(order) || math.IsInf(order, 0) {
+			continue
+		}
+		sum += order
+		amount++
+	}
+	if amount == 0 {
+		return "undefined"
+	}
+	switch order := sum / float64(amount); {
+	case order < 1.25:
+		return "linear"
+	case order < 1.75:
+		return "superlinear"
+	}
+	return "quadratic"
+}
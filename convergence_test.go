@@ -0,0 +1,43 @@
+package root_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestClassifyConvergence(t *testing.T) {
+	var (
+		linear      []float64
+		superlinear []float64
+		quadratic   []float64
+	)
+	for e := 0.5; 1e-12 < e; e *= 0.5 {
+		linear = append(linear, e)
+	}
+	for e := 0.5; 1e-12 < e; e = math.Pow(e, 1.5) {
+		superlinear = append(superlinear, e)
+	}
+	for e := 0.5; 1e-12 < e; e = e * e {
+		quadratic = append(quadratic, e)
+	}
+	tcs := []struct {
+		residuals []float64
+		expect    string
+	}{
+		{linear, "linear"},
+		{superlinear, "superlinear"},
+		{quadratic, "quadratic"},
+		{[]float64{1, 0.5}, "undefined"},
+		{[]float64{1, 1, 1, 1}, "undefined"},
+		{nil, "undefined"},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.expect, func(t *testing.T) {
+			if actual := root.ClassifyConvergence(tc.residuals); actual != tc.expect {
+				t.Errorf("not valid classification: %s for %v", actual, tc.residuals)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package root
+
+// FindFalsePosition finds root of function by regula falsi method with
+// Illinois modification. Next point of searching is the root of line
+// between borders of interval, but if the same border is retained twice,
+// then value of function on this border is halved. So, both borders are
+// moved, and the method is not slower bisection for convex functions.
+//
+// Documentation: https://en.wikipedia.org/wiki/Regula_falsi#The_Illinois_algorithm
+//
+//	Input data:
+//		f    - function of variable X for root-finding
+//		minX - minimal X
+//		maxX - maximal X
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// FindFalsePosition is same as Find with method Illinois, so errors
+// are same as in Find.
+//
+// Notes:
+//   - Concurrency acceptable
+//   - Panic-free function
+func FindFalsePosition[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64) (root F64, err error) {
+	return find(Config{Method: Illinois}, f, minX, maxX, nil)
+}
+
+// FindAndersonBjorck finds root of function by regula falsi method with
+// Anderson-Bjorck modification. Method is same as FindFalsePosition,
+// but value of function on the retained border is multiplied by factor
+// depending on values of function instead of halving. Usually the method
+// is faster Illinois modification.
+//
+// Documentation: https://en.wikipedia.org/wiki/Regula_falsi#Improvements_in_regula_falsi
+//
+//	Input data:
+//		f    - function of variable X for root-finding
+//		minX - minimal X
+//		maxX - maximal X
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// FindAndersonBjorck is same as Find with method AndersonBjorck, so
+// errors are same as in Find.
+//
+// Notes:
+//   - Concurrency acceptable
+//   - Panic-free function
+func FindAndersonBjorck[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64) (root F64, err error) {
+	return find(Config{Method: AndersonBjorck}, f, minX, maxX, nil)
+}
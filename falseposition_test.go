@@ -0,0 +1,132 @@
+package root_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindFalsePosition(t *testing.T) {
+	// convex function
+	f := func(x float64) (float64, error) {
+		return math.Exp(x) - math.Exp(-x) - 2, nil
+	}
+	evaluations := map[root.Method]int{}
+	for _, m := range []root.Method{root.Bisection, root.FalsePosition, root.Illinois} {
+		var calls int
+		g := func(x float64) (float64, error) {
+			calls++
+			return f(x)
+		}
+		if _, err := root.FindWith(root.Config{Method: m}, g, 0, 4); err != nil {
+			t.Fatalf("%s: %v", m, err)
+		}
+		evaluations[m] = calls
+	}
+	t.Logf("evaluations: %v", evaluations)
+	if evaluations[root.FalsePosition] <= evaluations[root.Bisection] {
+		t.Errorf("regula falsi is not stagnated")
+	}
+	if evaluations[root.Bisection] <= evaluations[root.Illinois] {
+		t.Errorf("Illinois modification is not faster bisection")
+	}
+	rootX, err := root.FindFalsePosition(f, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 1e-6 < math.Abs(rootX-math.Asinh(1)) {
+		t.Errorf("not valid root: %e", rootX)
+	}
+	t.Run("no sign change", func(t *testing.T) {
+		_, err := root.FindFalsePosition(f, 2, 4)
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.InternalErr {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+	t.Run("iterations", func(t *testing.T) {
+		defer func(m int) {
+			root.MaxIteration = m
+		}(root.MaxIteration)
+		root.MaxIteration = 2
+		_, err := root.FindFalsePosition(f, 0, 4)
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.MaximalIteration {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
+
+func TestFindAndersonBjorck(t *testing.T) {
+	var illinois, andersonBjorck int
+	for i := range tcs {
+		if i == 26 || i == 27 {
+			// flat parts of function
+			continue
+		}
+		for _, m := range []root.Method{root.Illinois, root.AndersonBjorck} {
+			res, err := root.FindResultWith(root.Config{Method: m},
+				func(x float64) (float64, error) {
+					return tcs[i].f(x), nil
+				}, tcs[i].Xmin, tcs[i].Xmax)
+			if err != nil {
+				t.Errorf("Case %d: %s: %v", i, m, err)
+				continue
+			}
+			if root.Precision < math.Abs(tcs[i].f(res.Root)) {
+				t.Errorf("Case %d: %s: not valid precision: %e",
+					i, m, math.Abs(tcs[i].f(res.Root)))
+			}
+			if m == root.Illinois {
+				illinois += res.Evaluations
+			} else {
+				andersonBjorck += res.Evaluations
+			}
+		}
+	}
+	t.Logf("evaluations: Illinois %d, Anderson-Bjorck %d", illinois, andersonBjorck)
+	if illinois < andersonBjorck {
+		t.Errorf("Anderson-Bjorck modification is slower Illinois")
+	}
+	f := func(x float64) (float64, error) {
+		return math.Exp(x) - math.Exp(-x) - 2, nil
+	}
+	rootX, err := root.FindAndersonBjorck(f, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 1e-6 < math.Abs(rootX-math.Asinh(1)) {
+		t.Errorf("not valid root: %e", rootX)
+	}
+	if _, err := root.FindAndersonBjorck(f, math.NaN(), 4); err == nil {
+		t.Errorf("not valid border is accepted")
+	}
+}
+
+func BenchmarkFindAndersonBjorck(b *testing.B) {
+	for _, name := range []string{"Find", "FindFalsePosition", "FindAndersonBjorck"} {
+		solve := root.Find[float64, float64]
+		switch name {
+		case "FindFalsePosition":
+			solve = root.FindFalsePosition[float64, float64]
+		case "FindAndersonBjorck":
+			solve = root.FindAndersonBjorck[float64, float64]
+		}
+		b.Run(name, func(b *testing.B) {
+			var calls int
+			for n := 0; n < b.N; n++ {
+				for i := range tcs {
+					_, _ = solve(func(x float64) (float64, error) {
+						calls++
+						return tcs[i].f(x), nil
+					}, tcs[i].Xmin, tcs[i].Xmax)
+				}
+			}
+			b.ReportMetric(float64(calls)/float64(b.N*len(tcs)), "calls/op")
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// Integer is constraint of integer-like types of X for FindInteger
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// FindInteger finds root of function on integer interval [minX, maxX]
+// by bisection. Searching is stopped, when borders of interval are
+// adjacent integer values. From both borders the X value with minimal
+// absolute value of function is returned, and for equal values the
+// left border. Function with fixed-point X, for example scaled int64,
+// is solved to the resolution of X type.
+//
+//	Input data:
+//		f    - function of integer variable X for root-finding
+//		minX - minimal X
+//		maxX - maximal X
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// Function values on borders must have opposite signs, otherwise
+// returns ErrorFind with type InternalErr and wrapped NoBracketError.
+//
+// Notes:
+//   - Panic-free function
+func FindInteger[I Integer, F64R ~float64](f func(I) (F64R, error), minX, maxX I) (root I, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	// replace borders
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	var (
+		xLeft, xRigth = minX, maxX
+		yLeft, yRigth F64R
+	)
+	if yLeft, err = f(xLeft); err != nil {
+		return
+	}
+	if yLeft == 0 {
+		return xLeft, nil
+	}
+	if yRigth, err = f(xRigth); err != nil {
+		return
+	}
+	if yRigth == 0 {
+		return xRigth, nil
+	}
+	if math.Signbit(float64(yLeft)) == math.Signbit(float64(yRigth)) {
+		err = ErrorFind{
+			Type: InternalErr,
+			Err: NoBracketError{
+				XLeft: float64(xLeft), XRight: float64(xRigth),
+				YLeft: float64(yLeft), YRight: float64(yRigth),
+			},
+		}
+		return
+	}
+	for {
+		// middle without overflow of sum and difference
+		xRoot := xLeft/2 + xRigth/2 + (xLeft%2+xRigth%2)/2
+		if xRoot <= xLeft || xRigth <= xRoot {
+			break // borders are adjacent
+		}
+		var yRoot F64R
+		if yRoot, err = f(xRoot); err != nil {
+			return
+		}
+		if math.IsNaN(float64(yRoot)) {
+			err = ErrorFind{
+				Type: NotValidValue,
+				Err:  fmt.Errorf("yRoot is NaN"),
+			}
+			return
+		}
+		if yRoot == 0 {
+			return xRoot, nil
+		}
+		if math.Signbit(float64(yLeft)) != math.Signbit(float64(yRoot)) {
+			xRigth, yRigth = xRoot, yRoot
+		} else {
+			xLeft, yLeft = xRoot, yRoot
+		}
+	}
+	if math.Abs(float64(yRigth)) < math.Abs(float64(yLeft)) {
+		return xRigth, nil
+	}
+	return xLeft, nil
+}
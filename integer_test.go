@@ -0,0 +1,63 @@
+package root_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+// Micro is fixed-point value with resolution 1e-6
+type Micro int64
+
+func TestFindInteger(t *testing.T) {
+	t.Run("fixed-point", func(t *testing.T) {
+		f := func(x Micro) (float64, error) {
+			v := float64(x) * 1e-6
+			return v*v - 2, nil
+		}
+		rootX, err := root.FindInteger(f, 0, 2000000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rootX != Micro(math.Round(math.Sqrt2*1e6)) {
+			t.Errorf("not valid root: %d", rootX)
+		}
+	})
+	t.Run("exact", func(t *testing.T) {
+		f := func(x int) (float64, error) {
+			return float64(x - 37), nil
+		}
+		rootX, err := root.FindInteger(f, 100, -100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rootX != 37 {
+			t.Errorf("not valid root: %d", rootX)
+		}
+	})
+	t.Run("full range", func(t *testing.T) {
+		f := func(x int8) (float64, error) {
+			return float64(x) + 0.3, nil
+		}
+		rootX, err := root.FindInteger(f, math.MinInt8, math.MaxInt8)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rootX != 0 {
+			t.Errorf("not valid root: %d", rootX)
+		}
+	})
+	t.Run("no sign change", func(t *testing.T) {
+		f := func(x int8) (float64, error) {
+			return float64(x)*float64(x) + 1, nil
+		}
+		_, err := root.FindInteger(f, -100, 100)
+		t.Logf("%v", err)
+		var nb root.NoBracketError
+		if !errors.As(err, &nb) {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
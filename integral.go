@@ -0,0 +1,90 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// FindIntegralCrossing finds the first X, when the integral of function
+// from minX to X reaches the target:
+//
+//	integral(f, minX, X) = target
+//
+// Integral is calculated by trapezoidal rule on uniform panels, so the
+// resolution of quadrature is (maxX-minX)/panels. Inside panel function
+// is linear interpolation of values on panel borders and the crossing
+// is found by Find.
+//
+//	Input data:
+//		f      - function of variable X
+//		minX   - minimal X, lower limit of integral
+//		maxX   - maximal X
+//		target - value of integral
+//		panels - amount of panels for integration
+//	Output data:
+//		root   - X value of crossing
+//		err    - error if some is not ok
+//
+// If integral does not reach the target, then returns ErrorFind with
+// wrapped ErrNoSignChange.
+//
+// Notes:
+//   - Panic-free function
+func FindIntegralCrossing(f func(float64) (float64, error), minX, maxX, target float64, panels int) (root float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	if panels < 1 {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("amount of panels is not valid: %d", panels),
+		}
+		return
+	}
+	var (
+		h        = (maxX - minX) / float64(panels)
+		x0       = minX
+		y0       float64
+		integral float64 // integral from minX to x0
+	)
+	if y0, err = f(x0); err != nil {
+		return
+	}
+	if target == 0 {
+		return minX, nil
+	}
+	for i := 1; i <= panels; i++ {
+		x1 := minX + h*float64(i)
+		var y1 float64
+		if y1, err = f(x1); err != nil {
+			return
+		}
+		area := (y0 + y1) / 2.0 * (x1 - x0)
+		if math.Signbit(integral-target) != math.Signbit(integral+area-target) ||
+			integral+area == target {
+			// crossing inside panel
+			var (
+				start = integral
+				slope = (y1 - y0) / (x1 - x0)
+			)
+			return Find(func(x float64) (float64, error) {
+				dx := x - x0
+				return start + (y0+slope*dx/2.0)*dx - target, nil
+			}, x0, x1)
+		}
+		x0, y0 = x1, y1
+		integral += area
+	}
+	err = ErrorFind{
+		Type: InternalErr,
+		Err: fmt.Errorf("%w: integral %s on [%s, %s] is not reached %s",
+			ErrNoSignChange, fmtFloat(integral), fmtFloat(minX), fmtFloat(maxX), fmtFloat(target)),
+	}
+	return
+}
@@ -0,0 +1,42 @@
+package root_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindIntegralCrossing(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return 2, nil
+	}
+	x, err := root.FindIntegralCrossing(f, 0, 5, 3, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 1e-6 < math.Abs(x-1.5) {
+		t.Errorf("not valid crossing: %e", x)
+	}
+	t.Run("linear", func(t *testing.T) {
+		// integral of 2*x from 0 to X is X^2
+		g := func(x float64) (float64, error) {
+			return 2 * x, nil
+		}
+		x, err := root.FindIntegralCrossing(g, 0, 5, 2, 7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-6 < math.Abs(x-math.Sqrt2) {
+			t.Errorf("not valid crossing: %e", x)
+		}
+	})
+	t.Run("not reached", func(t *testing.T) {
+		_, err := root.FindIntegralCrossing(f, 0, 1, 3, 10)
+		t.Logf("%v", err)
+		if !errors.Is(err, root.ErrNoSignChange) {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
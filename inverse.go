@@ -0,0 +1,302 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// FindEqual finds X, when value of function is equal target.
+// Result is root of function f(x)-target.
+// See Find for details.
+func FindEqual[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), target F64R, minX, maxX F64) (root F64, err error) {
+	return Find(Offset(f, -target), minX, maxX)
+}
+
+// Inverter is inverse of monotone function F on interval [MinX, MaxX]
+type Inverter[F64 ~float64] struct {
+	F          func(F64) (F64, error)
+	MinX, MaxX F64
+}
+
+// At returns X, when F(X) = y
+func (inv *Inverter[F64]) At(y F64) (F64, error) {
+	return FindEqual(inv.F, y, inv.MinX, inv.MaxX)
+}
+
+// AtSorted returns X for each value of ys, when F(X) = y.
+// Values ys must be sorted in increasing order.
+//
+// Bracket of each solve is seeded from the previous root, because for
+// monotone function the next root is on the same side of previous root.
+// Width of the bracket starts from distance between two previous roots
+// and doubles until the sign change is found. Function values on borders
+// of the bracket are known, so function is not calculated again for
+// them. Root inside of narrow bracket is found by method Illinois, so
+// the total cost of inversion is near-linear.
+func (inv *Inverter[F64]) AtSorted(ys []F64) (xs []F64, err error) {
+	if len(ys) == 0 {
+		return
+	}
+	for i := 1; i < len(ys); i++ {
+		if ys[i] < ys[i-1] {
+			err = ErrorFind{
+				Type: NotValidValue,
+				Err: fmt.Errorf("values is not sorted: %s after %s",
+					fmtFloat(ys[i]), fmtFloat(ys[i-1])),
+			}
+			return
+		}
+	}
+	minX, maxX := inv.MinX, inv.MaxX
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	// last function call
+	var xLast, yLast F64
+	f := func(x F64) (F64, error) {
+		y, err := inv.F(x)
+		xLast, yLast = x, y
+		return y, err
+	}
+	// direction of root moving
+	var yMin, yMax F64
+	if yMin, err = f(minX); err != nil {
+		return
+	}
+	if yMax, err = f(maxX); err != nil {
+		return
+	}
+	var (
+		dir   F64 = 1
+		bound     = maxX
+	)
+	if yMax < yMin {
+		dir, bound = -1, minX
+	}
+	// function values on borders of bracket are known, so function
+	// is not calculated again for them
+	var (
+		prev, next   = minX, maxX
+		yPrev, yNext = yMin, yMax
+	)
+	cached := func(x F64) (F64, error) {
+		switch x {
+		case prev:
+			return yPrev, nil
+		case next:
+			return yNext, nil
+		}
+		return f(x)
+	}
+	xs = make([]F64, len(ys))
+	if xs[0], err = FindEqual(cached, ys[0], minX, maxX); err != nil {
+		return nil, err
+	}
+	step := (maxX - minX) / F64(len(ys))
+	for i := 1; i < len(ys); i++ {
+		prev = xs[i-1]
+		if xLast == prev {
+			// the last call of Find is for the root
+			yPrev = yLast
+		} else if yPrev, err = f(prev); err != nil {
+			return nil, err
+		}
+		for {
+			if next = prev + dir*step; dir*(next-bound) > 0 {
+				next = bound
+			}
+			if yNext, err = f(next); err != nil {
+				return nil, err
+			}
+			if ys[i] <= yNext {
+				break // sign is changed
+			}
+			if next == bound {
+				err = ErrorFind{
+					Type: NotValidValue,
+					Err:  fmt.Errorf("value is out of range: %s", fmtFloat(ys[i])),
+				}
+				return nil, err
+			}
+			prev, yPrev = next, yNext
+			step *= 2
+		}
+		if xs[i], err = FindWith(Config{Method: Illinois}, Offset(cached, -ys[i]), prev, next); err != nil {
+			return nil, err
+		}
+		if gap := dir * (xs[i] - xs[i-1]); 0 < gap {
+			step = gap
+		}
+	}
+	return
+}
+
+// FindEqualBatch finds X for each target, when value of function is
+// equal target. Function values on borders of interval are calculated
+// only once for all targets. Error of each target is independent.
+//
+//	Input data:
+//		f       - function of variable X
+//		targets - values of function
+//		minX    - minimal X
+//		maxX    - maximal X
+//	Output data:
+//		xs      - X values for each target
+//		errs    - errors for each target
+func FindEqualBatch[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), targets []F64R, minX, maxX F64) (xs []F64, errs []error) {
+	xs = make([]F64, len(targets))
+	errs = make([]error, len(targets))
+	var (
+		yMin, errMin = f(minX)
+		yMax, errMax = f(maxX)
+	)
+	cached := func(x F64) (F64R, error) {
+		switch x {
+		case minX:
+			return yMin, errMin
+		case maxX:
+			return yMax, errMax
+		}
+		return f(x)
+	}
+	for i, target := range targets {
+		xs[i], errs[i] = FindEqual(cached, target, minX, maxX)
+	}
+	return
+}
+
+// thresholdSamples is amount of panels for searching of the first
+// crossing in FindThreshold
+const thresholdSamples = 100
+
+// FindThreshold finds the first X on interval [minX, maxX], when
+// function crosses the threshold in direction:
+//   - NegToPos is crossing from values less threshold to values more
+//     threshold, in other words rising over threshold
+//   - PosToNeg is crossing from values more threshold to values less
+//     threshold, in other words dropping below threshold
+//   - Any is first crossing in any direction
+//
+// Interval is divided into 100 uniform panels and the root of
+// function f(x)-threshold is found by Find in the first panel with
+// crossing in direction. If function is equal to threshold on minX,
+// then minX is crossing in direction of function on the first panel.
+//
+//	Input data:
+//		f         - function of variable X
+//		threshold - value of function
+//		minX      - minimal X
+//		maxX      - maximal X
+//		direction - direction of crossing
+//	Output data:
+//		root      - X value of crossing
+//		err       - error if some is not ok
+//
+// If crossing is not found, then returns ErrorFind with
+// wrapped ErrNoSignChange.
+//
+// Notes:
+//   - Panic-free function
+func FindThreshold[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), threshold F64R, minX, maxX F64, direction Direction) (root F64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	// replace borders
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	var (
+		g     = Offset(f, -threshold)
+		xLast = minX
+		yLast F64R
+	)
+	if yLast, err = g(xLast); err != nil {
+		return
+	}
+	for i := 1; i <= thresholdSamples; i++ {
+		x := minX + (maxX-minX)*F64(i)/F64(thresholdSamples)
+		var y F64R
+		if y, err = g(x); err != nil {
+			return
+		}
+		var (
+			start = i == 1 && yLast == 0 // threshold on the left border
+			up    = (yLast < 0 || start) && 0 <= y
+			down  = (0 < yLast || start) && y <= 0
+		)
+		if (up && direction != PosToNeg) || (down && direction != NegToPos) {
+			if start {
+				return xLast, nil
+			}
+			return Find(g, xLast, x)
+		}
+		xLast, yLast = x, y
+	}
+	err = ErrorFind{
+		Type: InternalErr,
+		Err: fmt.Errorf("%w: threshold %s in direction %s on [%s, %s]",
+			ErrNoSignChange, fmtFloat(threshold), direction,
+			fmtFloat(minX), fmtFloat(maxX)),
+	}
+	return
+}
+
+// FindPeriodic finds root of periodic function on one period
+// [x0, x0+period). Function is assumed to have single root on the
+// period, otherwise the first root is returned. Root is reduced
+// modulo the period to interval [x0, x0+period).
+//
+//	Input data:
+//		f      - periodic function of variable X
+//		x0     - start of period
+//		period - period of function
+//	Output data:
+//		root   - root of function
+//		err    - error if some is not ok
+//
+// If absolute value of function in x0 is less Precision, then x0 is
+// returned. See FindThreshold for details.
+//
+// Notes:
+//   - Panic-free function
+func FindPeriodic(f func(float64) (float64, error), x0, period float64) (root float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	if !(0 < period) || math.IsInf(period, 0) {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("period is not valid: %s", fmtFloat(period)),
+		}
+		return
+	}
+	// root on start of period
+	var y float64
+	if y, err = f(x0); err != nil {
+		return
+	}
+	if prec, _ := parameters(); math.Abs(y) < prec {
+		return x0, nil
+	}
+	if root, err = FindThreshold(f, 0, x0, x0+period, Any); err != nil {
+		return
+	}
+	// reduce to the period
+	root = x0 + math.Mod(root-x0, period)
+	if period <= root-x0 {
+		root = x0
+	}
+	return
+}
@@ -0,0 +1,218 @@
+package root_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindEqual(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return x * x, nil
+	}
+	x, err := root.FindEqual(f, 2.0, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 1e-5 < math.Abs(x-math.Sqrt2) {
+		t.Errorf("not valid root: %e", x)
+	}
+}
+
+func TestInverterAtSorted(t *testing.T) {
+	var counter int
+	inv := root.Inverter[float64]{
+		F: func(x float64) (float64, error) {
+			counter++
+			return math.Exp(x), nil
+		},
+		MinX: 0,
+		MaxX: 10,
+	}
+	ys := make([]float64, 1000)
+	for i := range ys {
+		ys[i] = 1.0 + float64(i)
+	}
+
+	counter = 0
+	for _, y := range ys {
+		if _, err := inv.At(y); err != nil {
+			t.Fatal(err)
+		}
+	}
+	independent := counter
+
+	counter = 0
+	xs, err := inv.AtSorted(ys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sorted := counter
+
+	t.Logf("amount of calls: independent = %d, sorted = %d", independent, sorted)
+	if independent < sorted*4 {
+		t.Errorf("sorted inversion is not efficient")
+	}
+	for i := range xs {
+		if 1e-5 < math.Abs(xs[i]-math.Log(ys[i])) {
+			t.Errorf("not valid root for %e: %e", ys[i], xs[i])
+		}
+	}
+
+	t.Run("decreasing", func(t *testing.T) {
+		inv := root.Inverter[float64]{
+			F: func(x float64) (float64, error) {
+				return math.Exp(-x), nil
+			},
+			MinX: 0,
+			MaxX: 10,
+		}
+		ys := []float64{0.001, 0.01, 0.1, 0.5}
+		xs, err := inv.AtSorted(ys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range xs {
+			if 1e-5 < math.Abs(xs[i]+math.Log(ys[i])) {
+				t.Errorf("not valid root for %e: %e", ys[i], xs[i])
+			}
+		}
+	})
+	t.Run("out of range", func(t *testing.T) {
+		_, err := inv.AtSorted([]float64{2, 3, 1e6})
+		t.Logf("%v", err)
+		if err == nil {
+			t.Fatalf("out of range value is accepted")
+		}
+	})
+	t.Run("not sorted", func(t *testing.T) {
+		_, err := inv.AtSorted([]float64{3, 2})
+		t.Logf("%v", err)
+		if err == nil {
+			t.Fatalf("not sorted values is accepted")
+		}
+	})
+}
+
+func TestFindEqualBatch(t *testing.T) {
+	var calls [2]int
+	f := func(x float64) (float64, error) {
+		switch x {
+		case 0:
+			calls[0]++
+		case 2:
+			calls[1]++
+		}
+		return x * x, nil
+	}
+	targets := []float64{0.25, 1, 9, 2}
+	xs, errs := root.FindEqualBatch(f, targets, 0, 2)
+	for i, target := range targets {
+		if target == 9 {
+			t.Logf("%v", errs[i])
+			if errs[i] == nil {
+				t.Errorf("out of range target is found")
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Errorf("target %e: %v", target, errs[i])
+			continue
+		}
+		if 1e-5 < math.Abs(xs[i]-math.Sqrt(target)) {
+			t.Errorf("not valid x: %e for %e", xs[i], target)
+		}
+	}
+	if calls != [2]int{1, 1} {
+		t.Errorf("borders are calculated several times: %v", calls)
+	}
+}
+
+func TestFindThreshold(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return math.Sin(x), nil
+	}
+	for _, tc := range []struct {
+		d      root.Direction
+		expect float64
+	}{
+		{root.Any, math.Pi / 6},
+		{root.NegToPos, math.Pi / 6},
+		{root.PosToNeg, 5 * math.Pi / 6},
+	} {
+		x, err := root.FindThreshold(f, 0.5, 0, 10, tc.d)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.d, err)
+		}
+		if 1e-5 < math.Abs(x-tc.expect) {
+			t.Errorf("%s: not valid crossing: %e != %e", tc.d, x, tc.expect)
+		}
+	}
+	t.Run("rising", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return x * x, nil
+		}
+		x, err := root.FindThreshold(g, 2, 0, 3, root.NegToPos)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-5 < math.Abs(x-math.Sqrt2) {
+			t.Errorf("not valid crossing: %e", x)
+		}
+		_, err = root.FindThreshold(g, 2, 0, 3, root.PosToNeg)
+		t.Logf("%v", err)
+		if !errors.Is(err, root.ErrNoSignChange) {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+	t.Run("left border", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return x, nil
+		}
+		for _, d := range []root.Direction{root.Any, root.NegToPos} {
+			x, err := root.FindThreshold(g, 0, 0, 1, d)
+			if err != nil {
+				t.Fatalf("%s: %v", d, err)
+			}
+			if x != 0 {
+				t.Errorf("%s: not valid crossing: %e", d, x)
+			}
+		}
+		_, err := root.FindThreshold(g, 0, 0, 1, root.PosToNeg)
+		if !errors.Is(err, root.ErrNoSignChange) {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
+
+func TestFindPeriodic(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return math.Sin(x), nil
+	}
+	for _, tc := range []struct {
+		x0, expect float64
+	}{
+		{1, math.Pi},
+		{0, 0},
+		{math.Pi, math.Pi},
+		{-0.5, 0},
+		{-2*math.Pi - 0.5, -2 * math.Pi},
+		{10, 4 * math.Pi},
+	} {
+		rootX, err := root.FindPeriodic(f, tc.x0, 2*math.Pi)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rootX < tc.x0 || tc.x0+2*math.Pi <= rootX {
+			t.Errorf("root %e is outside of period from %e", rootX, tc.x0)
+		}
+		if 1e-5 < math.Abs(rootX-tc.expect) {
+			t.Errorf("not valid root: %e != %e", rootX, tc.expect)
+		}
+	}
+	if _, err := root.FindPeriodic(f, 0, 0); err == nil {
+		t.Errorf("zero period is accepted")
+	}
+}
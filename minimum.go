@@ -0,0 +1,186 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// invPhi is inverse golden ratio
+var invPhi = (math.Sqrt(5.0) - 1.0) / 2.0
+
+// goldenSection is golden-section search of minimum for unimodal function
+// on interval [a, b]. Search is stopped, when function stop return true.
+func goldenSection(
+	f func(float64) (float64, error),
+	a, b float64,
+	stop func(x, y float64) bool,
+) (x, y float64, err error) {
+	var (
+		prec, maxIter = parameters()
+
+		c      = b - invPhi*(b-a)
+		d      = a + invPhi*(b-a)
+		yc, yd float64
+	)
+	if err = checkPrecision(prec); err != nil {
+		return
+	}
+	if yc, err = f(c); err != nil {
+		return
+	}
+	if stop != nil && stop(c, yc) {
+		return c, yc, nil
+	}
+	if yd, err = f(d); err != nil {
+		return
+	}
+	if stop != nil && stop(d, yd) {
+		return d, yd, nil
+	}
+	for iter := 0; ; iter++ {
+		if iter >= maxIter {
+			err = ErrorFind{
+				Type: MaximalIteration,
+				Err:  fmt.Errorf("Too many iterations: %d", iter),
+			}
+			return
+		}
+		if math.Abs(b-a) < prec*math.Max(1, math.Abs(c)) {
+			break
+		}
+		if yc < yd {
+			b, d, yd = d, c, yc
+			c = b - invPhi*(b-a)
+			if yc, err = f(c); err != nil {
+				return
+			}
+			x, y = c, yc
+		} else {
+			a, c, yc = c, d, yd
+			d = a + invPhi*(b-a)
+			if yd, err = f(d); err != nil {
+				return
+			}
+			x, y = d, yd
+		}
+		if err = checkValues(x, y); err != nil {
+			return
+		}
+		if stop != nil && stop(x, y) {
+			return
+		}
+	}
+	if yc < yd {
+		return c, yc, nil
+	}
+	return d, yd, nil
+}
+
+// derivativeStep is relative step of central finite difference
+// in FindMinimum. Value is near cube root of float64 epsilon.
+const derivativeStep = 6e-6
+
+// FindMinimum finds minimum of unimodal function on interval
+// [minX, maxX] as root of derivative of function by Find. Derivative
+// is calculated by central finite difference:
+//
+//	f'(x) = (f(x+h)-f(x-h)) / (2*h)
+//
+// Near borders of interval points of difference are limited by borders,
+// so function is not calculated outside of interval.
+//
+//	Input data:
+//		f    - unimodal function of variable X
+//		minX - minimal X
+//		maxX - maximal X
+//	Output data:
+//		xmin - X value of minimum
+//		ymin - value of function in minimum
+//		err  - error if some is not ok
+//
+// If minimum is on the border of interval, then derivative have not
+// changed sign and returns error same as Find.
+//
+// Notes:
+//   - Panic-free function
+func FindMinimum(f func(float64) (float64, error), minX, maxX float64) (xmin, ymin float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	var (
+		lo = math.Min(minX, maxX)
+		hi = math.Max(minX, maxX)
+	)
+	df := func(x float64) (float64, error) {
+		var (
+			h  = derivativeStep * math.Max(1, math.Abs(x))
+			xl = math.Max(x-h, lo) // one-sided difference on borders
+			xr = math.Min(x+h, hi)
+		)
+		yl, err := f(xl)
+		if err != nil {
+			return 0, err
+		}
+		yr, err := f(xr)
+		if err != nil {
+			return 0, err
+		}
+		return (yr - yl) / (xr - xl), nil
+	}
+	if xmin, err = Find(df, minX, maxX); err != nil {
+		return
+	}
+	ymin, err = f(xmin)
+	return
+}
+
+// GoldenSection finds minimum of unimodal function on interval
+// [minX, maxX] by golden-section search without derivatives. On each
+// iteration the interval is reduced by inverse golden ratio and only
+// one function call is needed. Search is stopped, when width of
+// interval is less Precision relative to X value of minimum.
+//
+// Documentation: https://en.wikipedia.org/wiki/Golden-section_search
+//
+//	Input data:
+//		f    - unimodal function of variable X
+//		minX - minimal X
+//		maxX - maximal X
+//	Output data:
+//		xmin - X value of minimum
+//		ymin - value of function in minimum
+//		err  - error if some is not ok
+//
+// Notes:
+//   - Panic-free function
+func GoldenSection(f func(float64) (float64, error), minX, maxX float64) (xmin, ymin float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	for _, x := range []float64{minX, maxX} {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			err = ErrorFind{
+				Type: NotValidValue,
+				Err:  fmt.Errorf("border is not valid: %s", fmtFloat(x)),
+			}
+			return
+		}
+	}
+	// replace borders
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	return goldenSection(f, minX, maxX, nil)
+}
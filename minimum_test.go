@@ -0,0 +1,94 @@
+package root_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindMinimum(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		f          func(float64) (float64, error)
+		minX, maxX float64
+		xmin       float64
+	}{
+		{"parabola", func(x float64) (float64, error) {
+			return (x-1.3)*(x-1.3) + 2, nil
+		}, 0, 3, 1.3},
+		{"cosine", func(x float64) (float64, error) {
+			return math.Cos(x), nil
+		}, 2, 4, math.Pi},
+		{"exponential", func(x float64) (float64, error) {
+			return math.Exp(x) - 2*x, nil
+		}, -5, 5, math.Ln2},
+		{"square root", func(x float64) (float64, error) {
+			if x < 0 || 1 < x {
+				return 0, fmt.Errorf("outside of domain: %e", x)
+			}
+			return x - math.Sqrt(x), nil
+		}, 0, 1, 0.25},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			xmin, ymin, err := root.FindMinimum(tc.f, tc.minX, tc.maxX)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if 1e-5 < math.Abs(xmin-tc.xmin) {
+				t.Errorf("not valid minimum: %e != %e", xmin, tc.xmin)
+			}
+			if y, _ := tc.f(xmin); y != ymin {
+				t.Errorf("not valid value: %e != %e", ymin, y)
+			}
+		})
+	}
+	t.Run("border", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return x * x, nil
+		}
+		_, _, err := root.FindMinimum(f, 1, 2)
+		t.Logf("%v", err)
+		if err == nil {
+			t.Errorf("minimum on border is found")
+		}
+	})
+}
+
+func TestGoldenSection(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		// kink in minimum, so derivative is not continuous
+		return math.Abs(x-1.3) + 2, nil
+	}
+	xmin, ymin, err := root.GoldenSection(f, 3, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 1e-5 < math.Abs(xmin-1.3) {
+		t.Errorf("not valid minimum: %e", xmin)
+	}
+	if y, _ := f(xmin); y != ymin {
+		t.Errorf("not valid value: %e != %e", ymin, y)
+	}
+	t.Run("Inf", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			if 0.9 < x {
+				return math.Inf(-1), nil
+			}
+			return -x, nil
+		}
+		_, _, err := root.GoldenSection(g, 0, 1)
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.NotValidValue {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+	t.Run("border", func(t *testing.T) {
+		if _, _, err := root.GoldenSection(f, 0, math.NaN()); err == nil {
+			t.Errorf("not valid border is accepted")
+		}
+	})
+}
@@ -0,0 +1,212 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// FindPair finds both roots of function with V-shaped residual, when
+// interval contains exactly two roots.
+// Function values on borders must have the same sign. Interior extremum
+// is located by golden-section search, then the interval is splitted
+// in the extremum and each part is bisected by Find.
+//
+//	Input data:
+//		f    - function of variable X for root-finding
+//		minX - minimal X
+//		maxX - maximal X
+//	Output data:
+//		r1   - left root of function
+//		r2   - right root of function
+//		err  - error if some is not ok
+//
+// If the extremum have not opposite sign, then returns ErrorFind
+// with wrapped ErrNoSignChange.
+//
+// Notes:
+//   - Panic-free function
+func FindPair[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64) (r1, r2 F64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	// replace borders
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	var (
+		yLeft, errLeft   = f(minX)
+		yRigth, errRigth = f(maxX)
+	)
+	for _, errLocal := range []error{errLeft, errRigth} {
+		if errLocal != nil {
+			err = errLocal
+			return
+		}
+	}
+	if yLeft == 0 || yRigth == 0 || math.Signbit(float64(yLeft)) != math.Signbit(float64(yRigth)) {
+		err = ErrorFind{
+			Type: InternalErr,
+			Err: fmt.Errorf("borders are not same sign: [%s, %s]",
+				fmtFloat(yLeft), fmtFloat(yRigth)),
+		}
+		return
+	}
+	// function for minimization
+	sign := 1.0
+	if yLeft < 0 {
+		// search maximum
+		sign = -1.0
+	}
+	g := func(x float64) (float64, error) {
+		y, err := f(F64(x))
+		return sign * float64(y), err
+	}
+	xm, ym, err := goldenSection(g, float64(minX), float64(maxX),
+		func(_, y float64) bool {
+			return y < 0 // sign is changed
+		})
+	if err != nil {
+		return
+	}
+	if 0 <= ym {
+		err = ErrorFind{
+			Type: InternalErr,
+			Err: fmt.Errorf("%w: extremum %s at %s",
+				ErrNoSignChange, fmtFloat(sign*ym), fmtFloat(xm)),
+		}
+		return
+	}
+	if r1, err = Find(f, minX, F64(xm)); err != nil {
+		return
+	}
+	if r2, err = Find(f, F64(xm), maxX); err != nil {
+		return
+	}
+	return
+}
+
+// FindAllVerbose finds all roots of function on interval [minX, maxX].
+// Interval is divided into uniform panels, and the root is searched by
+// Find in each panel with sign change of function on panel borders
+// found same as in Brackets. Root in the node between panels is related
+// to the right panel.
+//
+//	Input data:
+//		f            - function of variable X for root-finding
+//		minX         - minimal X
+//		maxX         - maximal X
+//		subdivisions - amount of panels
+//	Output data:
+//		roots        - roots of function in increasing order
+//		panels       - borders of panels
+//		signChanges  - true for panels with sign change
+//		errs         - errors of root-finding in panels
+//		err          - error if some is not ok
+//
+// Error of root-finding in panel, for example ErrorFind with type
+// Discontinuity in panel with pole of function, is stored in errs and
+// searching is continued in next panels. Searching is stopped by error
+// of function and by ErrorFind with type Recovery or NotValidValue.
+//
+// Notes:
+//   - Panic-free function
+func FindAllVerbose[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64, subdivisions int) (roots []F64, panels [][2]F64, signChanges []bool, errs []error, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	if subdivisions < 1 {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("amount of subdivisions is not valid: %d", subdivisions),
+		}
+		return
+	}
+	// replace borders
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	xs, ys, err := Sample(func(x float64) (float64, error) {
+		y, err := f(F64(x))
+		return float64(y), err
+	}, float64(minX), float64(maxX), subdivisions+1)
+	if err != nil {
+		return
+	}
+	var errF error // error of function
+	g := func(x F64) (F64R, error) {
+		y, err := f(x)
+		if err != nil {
+			errF = err
+		}
+		return y, err
+	}
+	panels = make([][2]F64, subdivisions)
+	signChanges = make([]bool, subdivisions)
+	errs = make([]error, subdivisions)
+	for i := range panels {
+		panels[i] = [2]F64{F64(xs[i]), F64(xs[i+1])}
+		if signChanges[i] = signChange(ys, i); !signChanges[i] {
+			continue
+		}
+		root, errPanel := Find(g, panels[i][0], panels[i][1])
+		if errPanel != nil {
+			var ef ErrorFind
+			if errF != nil || (errors.As(errPanel, &ef) &&
+				(ef.Type == Recovery || ef.Type == NotValidValue)) {
+				err = errPanel
+				return
+			}
+			errs[i] = errPanel
+			continue
+		}
+		roots = append(roots, root)
+	}
+	return
+}
+
+// FindAll finds all roots of function on interval [minX, maxX] by
+// FindAllVerbose. Roots with distance less Precision are merged.
+//
+//	Input data:
+//		f        - function of variable X for root-finding
+//		minX     - minimal X
+//		maxX     - maximal X
+//		segments - amount of panels
+//	Output data:
+//		roots    - roots of function in increasing order
+//		err      - error if some is not ok
+//
+// Root between two sign changes of function inside one panel is not
+// found, so amount of panels must be enough for separation of roots.
+// Panels with error of root-finding, for example with pole of function,
+// are skipped, see FindAllVerbose.
+//
+// Notes:
+//   - Panic-free function
+func FindAll[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64, segments int) (roots []F64, err error) {
+	var all []F64
+	if all, _, _, _, err = FindAllVerbose(f, minX, maxX, segments); err != nil {
+		return
+	}
+	prec, _ := parameters()
+	for _, r := range all {
+		if 0 < len(roots) && math.Abs(float64(r-roots[len(roots)-1])) < prec {
+			continue
+		}
+		roots = append(roots, r)
+	}
+	return
+}
@@ -0,0 +1,191 @@
+package root_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindPair(t *testing.T) {
+	t.Run("parabola", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return (x - 0.3) * (x - 0.7), nil
+		}
+		r1, r2, err := root.FindPair(f, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-5 < math.Abs(r1-0.3) || 1e-5 < math.Abs(r2-0.7) {
+			t.Errorf("not valid roots: %e %e", r1, r2)
+		}
+	})
+	t.Run("negative", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return -(x - 0.3) * (x - 0.7), nil
+		}
+		r1, r2, err := root.FindPair(f, 1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-5 < math.Abs(r1-0.3) || 1e-5 < math.Abs(r2-0.7) {
+			t.Errorf("not valid roots: %e %e", r1, r2)
+		}
+	})
+	t.Run("no roots", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return (x-0.5)*(x-0.5) + 1, nil
+		}
+		_, _, err := root.FindPair(f, 0, 1)
+		t.Logf("%v", err)
+		if !errors.Is(err, root.ErrNoSignChange) {
+			t.Fatalf("not valid error: %v", err)
+		}
+	})
+	t.Run("one root", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return x - 0.5, nil
+		}
+		_, _, err := root.FindPair(f, 0, 1)
+		t.Logf("%v", err)
+		if err == nil {
+			t.Fatalf("pair is found for one root")
+		}
+	})
+}
+
+func TestFindAllVerbose(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return (x - 0.25) * (x - 0.55) * (x - 0.85), nil
+	}
+	roots, panels, signChanges, _, err := root.FindAllVerbose(f, 0, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(panels) != 10 || len(signChanges) != 10 {
+		t.Fatalf("not valid amount of panels: %d %d", len(panels), len(signChanges))
+	}
+	for i := range panels {
+		expect := i == 2 || i == 5 || i == 8
+		if signChanges[i] != expect {
+			t.Errorf("panel %d %v: not valid sign change", i, panels[i])
+		}
+	}
+	expect := []float64{0.25, 0.55, 0.85}
+	if len(roots) != len(expect) {
+		t.Fatalf("not valid amount of roots: %v", roots)
+	}
+	for i := range roots {
+		if 1e-5 < math.Abs(roots[i]-expect[i]) {
+			t.Errorf("not valid root: %e != %e", roots[i], expect[i])
+		}
+	}
+	t.Run("node", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return x - 0.5, nil
+		}
+		roots, _, signChanges, _, err := root.FindAllVerbose(g, 0, 1, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(roots) != 1 || roots[0] != 0.5 {
+			t.Errorf("not valid roots: %v", roots)
+		}
+		if !signChanges[2] {
+			t.Errorf("root in node is not related to the right panel: %v", signChanges)
+		}
+	})
+	t.Run("pole", func(t *testing.T) {
+		tan := func(x float64) (float64, error) {
+			return math.Tan(x), nil
+		}
+		roots, panels, _, errs, err := root.FindAllVerbose(tan, 0.5, 4, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(roots) != 1 || 1e-5 < math.Abs(roots[0]-math.Pi) {
+			t.Errorf("not valid roots: %v", roots)
+		}
+		var poles int
+		for i := range panels {
+			if errs[i] == nil {
+				continue
+			}
+			t.Logf("%v: %v", panels[i], errs[i])
+			var ef root.ErrorFind
+			if !errors.As(errs[i], &ef) || ef.Type != root.Discontinuity ||
+				!(panels[i][0] < math.Pi/2 && math.Pi/2 < panels[i][1]) {
+				t.Errorf("not valid error in panel %v: %v", panels[i], errs[i])
+			}
+			poles++
+		}
+		if poles != 1 {
+			t.Errorf("not valid amount of poles: %d", poles)
+		}
+	})
+	t.Run("function error", func(t *testing.T) {
+		errF := errors.New("function error")
+		g := func(x float64) (float64, error) {
+			if 0.5 < x && x < 0.6 {
+				return 0, errF
+			}
+			return x - 0.55, nil
+		}
+		if _, _, _, _, err := root.FindAllVerbose(g, 0, 1, 4); !errors.Is(err, errF) {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
+
+func TestFindAll(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return math.Sin(x), nil
+	}
+	roots, err := root.FindAll(f, 0.5, 10, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("%v", roots)
+	for i, r := range roots {
+		if y, _ := f(r); root.Precision < math.Abs(y) {
+			t.Errorf("not valid root %e: %e", r, y)
+		}
+		if 0 < i && r-roots[i-1] < root.Precision {
+			t.Errorf("roots are not sorted or same: %v", roots)
+		}
+	}
+	if len(roots) != 3 {
+		t.Errorf("not valid amount of roots: %v", roots)
+	}
+	t.Run("pole", func(t *testing.T) {
+		tan := func(x float64) (float64, error) {
+			return math.Tan(x), nil
+		}
+		roots, err := root.FindAll(tan, 0.5, 4, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(roots) != 1 || 1e-5 < math.Abs(roots[0]-math.Pi) {
+			t.Errorf("not valid roots: %v", roots)
+		}
+	})
+	t.Run("close", func(t *testing.T) {
+		// close roots near the node between panels
+		g := func(x float64) (float64, error) {
+			return (x - 0.49999) * (x - 0.50001), nil
+		}
+		roots, err := root.FindAll(g, 0, 1, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(roots) != 1 {
+			t.Errorf("roots are not merged: %v", roots)
+		}
+	})
+	t.Run("not valid", func(t *testing.T) {
+		if _, err := root.FindAll(f, 0, 1, 0); err == nil {
+			t.Errorf("zero segments is accepted")
+		}
+	})
+}
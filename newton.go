@@ -0,0 +1,499 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// maxBacktrack is max allowable amount of step halvings
+// in FindDampedNewton
+const maxBacktrack = 30
+
+// FindDampedNewton finds root of function by Newton's method with step
+// damping. After calculation of Newton step, the step is halved until
+// the absolute value of function is reduced.
+//
+// Documentation: https://en.wikipedia.org/wiki/Newton%27s_method
+//
+//	Input data:
+//		f    - function of variable X for root-finding
+//		df   - derivative of function
+//		x0   - initial X
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// If absolute value of function is not reduced after 30 halvings,
+// then returns ErrorFind with type NotValidValue.
+//
+// Notes:
+//   - Panic-free function
+func FindDampedNewton[F64 ~float64](f, df func(F64) (F64, error), x0 F64) (root F64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	var (
+		x, y = x0, F64(0)
+
+		prec, maxIter = parameters()
+	)
+	if err = checkPrecision(prec); err != nil {
+		return
+	}
+	if y, err = f(x); err != nil {
+		return
+	}
+	for iter := 0; ; iter++ {
+		if y == 0 {
+			break // exact root
+		}
+		if iter >= maxIter {
+			err = ErrorFind{
+				Type: MaximalIteration,
+				Err:  fmt.Errorf("Too many iterations: %d", iter),
+			}
+			return
+		}
+		var d F64
+		if d, err = df(x); err != nil {
+			return
+		}
+		if d == 0 || math.IsNaN(float64(d)) || math.IsInf(float64(d), 0) {
+			err = ErrorFind{
+				Type: InternalErr,
+				Err:  fmt.Errorf("not valid derivative %s at %s", fmtFloat(d), fmtFloat(x)),
+			}
+			return
+		}
+		var (
+			step   = -y / d
+			xn, yn F64
+			reduce bool
+		)
+		for k := 0; k < maxBacktrack; k++ {
+			xn = x + step
+			if yn, err = f(xn); err != nil {
+				return
+			}
+			if math.Abs(float64(yn)) < math.Abs(float64(y)) {
+				reduce = true
+				break
+			}
+			step /= 2.0
+		}
+		if err = checkValues(float64(xn), float64(yn)); err != nil {
+			return
+		}
+		if !reduce {
+			err = ErrorFind{
+				Type: NotValidValue,
+				Err: fmt.Errorf("residual is not reduced at %s: %s",
+					fmtFloat(x), fmtFloat(y)),
+			}
+			return
+		}
+		x, y = xn, yn
+		if math.Abs(float64(y)) < prec && converged(float64(step), float64(x), prec) {
+			break // find the solution
+		}
+	}
+	root = x
+	return
+}
+
+// converged returns true, if step is less precision relative to x value.
+// For zero x value, step is compared with precision.
+func converged(step, x, prec float64) bool {
+	if x == 0 {
+		return math.Abs(step) < prec
+	}
+	return math.Abs(step/x) < prec
+}
+
+// checkValues returns error for NaN and Inf values
+func checkValues(x, y float64) error {
+	if math.IsNaN(x) {
+		return ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("x is NaN"),
+		}
+	}
+	if math.IsNaN(y) {
+		return ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("y is NaN"),
+		}
+	}
+	if math.IsInf(x, 0) {
+		return ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("x is Inf"),
+		}
+	}
+	if math.IsInf(y, 0) {
+		return ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("y is Inf"),
+		}
+	}
+	return nil
+}
+
+// FindComplexStepNewton finds root of function by Newton's method with
+// derivative by complex-step differentiation:
+//
+//	f'(x) = Im(f(x+i*h))/h
+//
+// Derivative is calculated without subtraction cancellation, so step h
+// may be very small, for example 1e-20.
+// Function must be analytic and real for real argument.
+//
+// Documentation: https://en.wikipedia.org/wiki/Numerical_differentiation#Complex-variable_methods
+//
+//	Input data:
+//		f    - analytic function of complex variable
+//		x0   - initial X
+//		h    - step of differentiation
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// See FindDampedNewton for details.
+func FindComplexStepNewton(f func(complex128) (complex128, error), x0 float64, h float64) (root float64, err error) {
+	if !(0 < h) || math.IsInf(h, 0) {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("step is not valid: %s", fmtFloat(h)),
+		}
+		return
+	}
+	fr := func(x float64) (float64, error) {
+		y, err := f(complex(x, 0))
+		return real(y), err
+	}
+	df := func(x float64) (float64, error) {
+		y, err := f(complex(x, h))
+		return imag(y) / h, err
+	}
+	return FindDampedNewton(fr, df, x0)
+}
+
+// FindNewton finds root of function by Newton's method with
+// derivative of function on interval [minX, maxX] with sign change.
+// Bisection step is used, when Newton step is outside of interval or
+// absolute value of derivative is less Precision. Interval is reduced
+// on each iteration by sign of function.
+//
+// Documentation: https://en.wikipedia.org/wiki/Newton%27s_method
+//
+//	Input data:
+//		f    - function of variable X for root-finding
+//		df   - derivative of function
+//		x0   - initial X
+//		minX - minimal X
+//		maxX - maximal X
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// Convergence is checked same as in Find.
+//
+// Notes:
+//   - Panic-free function
+func FindNewton(f, df func(float64) (float64, error), x0, minX, maxX float64) (root float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	var (
+		prec, maxIter = parameters()
+	)
+	if err = checkPrecision(prec); err != nil {
+		return
+	}
+	// replace borders
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	if !(minX <= x0 && x0 <= maxX) {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err: fmt.Errorf("initial X %s is outside of [%s, %s]",
+				fmtFloat(x0), fmtFloat(minX), fmtFloat(maxX)),
+		}
+		return
+	}
+	var (
+		a, b   = minX, maxX
+		ya, yb float64
+		x, y   = x0, 0.0
+	)
+	if ya, err = f(a); err != nil {
+		return
+	}
+	if math.Abs(ya) < prec {
+		return a, nil
+	}
+	if yb, err = f(b); err != nil {
+		return
+	}
+	if math.Abs(yb) < prec {
+		return b, nil
+	}
+	if math.Signbit(ya) == math.Signbit(yb) {
+		err = ErrorFind{
+			Type: InternalErr,
+			Err: fmt.Errorf("%w: [%s, %s]",
+				ErrNoSignChange, fmtFloat(ya), fmtFloat(yb)),
+		}
+		return
+	}
+	if y, err = f(x); err != nil {
+		return
+	}
+	for iter := 0; ; iter++ {
+		if y == 0 {
+			break // exact root
+		}
+		if iter >= maxIter {
+			err = ErrorFind{
+				Type: MaximalIteration,
+				Err:  fmt.Errorf("Too many iterations: %d", iter),
+			}
+			return
+		}
+		var d float64
+		if d, err = df(x); err != nil {
+			return
+		}
+		xn := x - y/d
+		if !(prec <= math.Abs(d)) || math.IsInf(d, 0) || !(a < xn && xn < b) {
+			// bisection
+			xn = a + (b-a)/2.0
+		}
+		step := xn - x
+		x = xn
+		if y, err = f(x); err != nil {
+			return
+		}
+		if err = checkValues(x, y); err != nil {
+			return
+		}
+		// reduce interval
+		if math.Signbit(y) == math.Signbit(ya) {
+			a, ya = x, y
+		} else {
+			b, yb = x, y
+		}
+		if math.Abs(y) < prec && (converged(step, x, prec) || converged(b-a, x, prec)) {
+			break // find the solution
+		}
+	}
+	root = x
+	return
+}
+
+// halleyStep is relative step of finite differences in FindHalleyFD.
+// Value is near fourth root of float64 epsilon, that is balance of
+// truncation and rounding errors for second derivative.
+const halleyStep = 1e-4
+
+// FindHalleyFD finds root of function by Halley's method with first
+// and second derivatives by central finite differences:
+//
+//	x = x - 2*f*f' / (2*f'*f' - f*f'')
+//
+// Step of finite differences is relative to the magnitude of x0.
+// If denominator of Halley's step is near zero, then secant step
+// by the previous point is used.
+//
+// Documentation: https://en.wikipedia.org/wiki/Halley%27s_method
+//
+//	Input data:
+//		f    - function of variable X for root-finding
+//		x0   - initial X
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// Convergence is checked same as in FindDampedNewton.
+//
+// Notes:
+//   - Panic-free function
+func FindHalleyFD(f func(float64) (float64, error), x0 float64) (root float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	var (
+		prec, maxIter = parameters()
+		h             = halleyStep * math.Max(1, math.Abs(x0))
+		x, y          = x0, 0.0
+	)
+	if err = checkPrecision(prec); err != nil {
+		return
+	}
+	if y, err = f(x); err != nil {
+		return
+	}
+	if err = checkValues(x, y); err != nil {
+		return
+	}
+	for iter := 0; ; iter++ {
+		if y == 0 {
+			break // exact root
+		}
+		if iter >= maxIter {
+			err = ErrorFind{
+				Type: MaximalIteration,
+				Err:  fmt.Errorf("Too many iterations: %d", iter),
+			}
+			return
+		}
+		var yl, yr float64
+		if yl, err = f(x - h); err != nil {
+			return
+		}
+		if yr, err = f(x + h); err != nil {
+			return
+		}
+		var (
+			d1    = (yr - yl) / (2 * h)
+			d2    = (yr - 2*y + yl) / (h * h)
+			denom = 2*d1*d1 - y*d2
+			step  float64
+		)
+		if math.Abs(denom) <= prec*math.Abs(d1) || math.IsNaN(denom) {
+			// secant step by the nearest point of finite differences
+			if yr == y {
+				err = ErrorFind{
+					Type: NotValidValue,
+					Err:  fmt.Errorf("function is flat at %s", fmtFloat(x)),
+				}
+				return
+			}
+			step = -y * h / (yr - y)
+		} else {
+			step = -2 * y * d1 / denom
+		}
+		x += step
+		if y, err = f(x); err != nil {
+			return
+		}
+		if err = checkValues(x, y); err != nil {
+			return
+		}
+		if math.Abs(y) < prec && converged(step, x, prec) {
+			break // find the solution
+		}
+	}
+	root = x
+	return
+}
+
+// FindNewtonFused is same as FindDampedNewton, but value and derivative
+// of function are calculated together by one call, for example by
+// automatic differentiation with dual numbers. Function is called once
+// per step of Newton's method, and derivative from the last accepted
+// step is reused for the next step.
+//
+//	Input data:
+//		fd   - function returns value and derivative of function
+//		x0   - initial X
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// See FindDampedNewton for details.
+//
+// Notes:
+//   - Panic-free function
+func FindNewtonFused(fd func(float64) (val, deriv float64, err error), x0 float64) (root float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	var (
+		x, y, d       = x0, 0.0, 0.0
+		prec, maxIter = parameters()
+	)
+	if err = checkPrecision(prec); err != nil {
+		return
+	}
+	if y, d, err = fd(x); err != nil {
+		return
+	}
+	for iter := 0; ; iter++ {
+		if y == 0 {
+			break // exact root
+		}
+		if iter >= maxIter {
+			err = ErrorFind{
+				Type: MaximalIteration,
+				Err:  fmt.Errorf("Too many iterations: %d", iter),
+			}
+			return
+		}
+		if d == 0 || math.IsNaN(d) || math.IsInf(d, 0) {
+			err = ErrorFind{
+				Type: InternalErr,
+				Err:  fmt.Errorf("not valid derivative %s at %s", fmtFloat(d), fmtFloat(x)),
+			}
+			return
+		}
+		var (
+			step       = -y / d
+			xn, yn, dn float64
+			reduce     bool
+		)
+		for k := 0; k < maxBacktrack; k++ {
+			xn = x + step
+			if yn, dn, err = fd(xn); err != nil {
+				return
+			}
+			if math.Abs(yn) < math.Abs(y) {
+				reduce = true
+				break
+			}
+			step /= 2.0
+		}
+		if err = checkValues(xn, yn); err != nil {
+			return
+		}
+		if !reduce {
+			err = ErrorFind{
+				Type: NotValidValue,
+				Err: fmt.Errorf("residual is not reduced at %s: %s",
+					fmtFloat(x), fmtFloat(y)),
+			}
+			return
+		}
+		x, y, d = xn, yn, dn
+		if math.Abs(y) < prec && converged(step, x, prec) {
+			break // find the solution
+		}
+	}
+	root = x
+	return
+}
@@ -0,0 +1,285 @@
+package root_test
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindDampedNewton(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return math.Atan(x), nil
+	}
+	df := func(x float64) (float64, error) {
+		return 1.0 / (1.0 + x*x), nil
+	}
+	const x0 = 2.0
+	t.Run("undamped", func(t *testing.T) {
+		// Newton's method without damping is diverged
+		x := x0
+		for i := 0; i < 5; i++ {
+			y, _ := f(x)
+			d, _ := df(x)
+			xn := x - y/d
+			if math.Abs(xn) < math.Abs(x) {
+				t.Fatalf("undamped iterations are converged")
+			}
+			x = xn
+		}
+	})
+	t.Run("damped", func(t *testing.T) {
+		rootX, err := root.FindDampedNewton(f, df, x0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root.Precision < math.Abs(rootX) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("no root", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return x*x + 1, nil
+		}
+		dg := func(x float64) (float64, error) {
+			return 2 * x, nil
+		}
+		_, err := root.FindDampedNewton(g, dg, 1)
+		t.Logf("%v", err)
+		if err == nil {
+			t.Fatalf("root is found")
+		}
+	})
+}
+
+func TestFindDampedNewtonExact(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return x - 1, nil
+	}
+	df := func(x float64) (float64, error) {
+		return 1, nil
+	}
+	rootX, err := root.FindDampedNewton(f, df, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rootX != 1 {
+		t.Errorf("not valid root: %e", rootX)
+	}
+}
+
+func TestFindComplexStepNewton(t *testing.T) {
+	const h = 1e-20
+	f := func(z complex128) (complex128, error) {
+		return cmplx.Exp(z) - 2, nil
+	}
+	rootX, err := root.FindComplexStepNewton(f, 3, h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 1e-12 < math.Abs(rootX-math.Ln2) {
+		t.Errorf("not valid root: %.16e", rootX)
+	}
+	t.Run("central difference", func(t *testing.T) {
+		// complex-step derivative is without subtraction cancellation
+		const x = 1.0
+		for _, h := range []float64{1e-6, 1e-7, 1e-8} {
+			var (
+				central = (math.Exp(x+h) - math.Exp(x-h)) / (2 * h)
+				step    = imag(cmplx.Exp(complex(x, h))) / h
+				errC    = math.Abs(central - math.E)
+				errS    = math.Abs(step - math.E)
+			)
+			t.Logf("h = %.0e: central %.3e, complex step %.3e", h, errC, errS)
+			if errC <= errS {
+				t.Errorf("complex step is not more accurate for h = %.0e", h)
+			}
+			rootX, err := root.FindComplexStepNewton(f, 3, h)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if 1e-12 < math.Abs(rootX-math.Ln2) {
+				t.Errorf("not valid root for h = %.0e: %.16e", h, rootX)
+			}
+		}
+	})
+	t.Run("not valid step", func(t *testing.T) {
+		if _, err := root.FindComplexStepNewton(f, 3, 0); err == nil {
+			t.Errorf("zero step is accepted")
+		}
+	})
+}
+
+func TestFindNewton(t *testing.T) {
+	t.Run("cubic", func(t *testing.T) {
+		var calls int
+		f := func(x float64) (float64, error) {
+			calls++
+			return x*x*x - 2*x - 5, nil
+		}
+		df := func(x float64) (float64, error) {
+			return 3*x*x - 2, nil
+		}
+		rootX, err := root.FindNewton(f, df, 2, 0, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if y, _ := f(rootX); root.Precision < math.Abs(y) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+		newton := calls
+		res, err := root.FindResult(f, 0, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("calls: Newton %d, bisection %d", newton, res.Evaluations)
+		if res.Evaluations <= newton {
+			t.Errorf("Newton's method is not faster")
+		}
+	})
+	t.Run("outside", func(t *testing.T) {
+		// Newton step is outside of interval
+		f := func(x float64) (float64, error) {
+			return math.Atan(x), nil
+		}
+		df := func(x float64) (float64, error) {
+			return 1.0 / (1.0 + x*x), nil
+		}
+		rootX, err := root.FindNewton(f, df, 5, -1, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root.Precision < math.Abs(rootX) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("zero derivative", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return x*x*x - 1, nil
+		}
+		df := func(x float64) (float64, error) {
+			return 3 * x * x, nil
+		}
+		rootX, err := root.FindNewton(f, df, 0, -2, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-6 < math.Abs(rootX-1) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("no sign change", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return x*x + 1, nil
+		}
+		df := func(x float64) (float64, error) {
+			return 2 * x, nil
+		}
+		_, err := root.FindNewton(f, df, 0, -2, 3)
+		if !errors.Is(err, root.ErrNoSignChange) {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
+
+func TestFindHalleyFD(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		f    func(float64) (float64, error)
+		x0   float64
+		root float64
+	}{
+		{"cubic", func(x float64) (float64, error) {
+			return x*x*x - 2*x - 5, nil
+		}, 2, 2.0945514815423265},
+		{"exponential", func(x float64) (float64, error) {
+			return math.Exp(x) - 2, nil
+		}, 3, math.Ln2},
+		{"large", func(x float64) (float64, error) {
+			return math.Log(x) - 20, nil
+		}, 4e8, math.Exp(20)},
+		{"inflection", func(x float64) (float64, error) {
+			// second derivative is zero in the root
+			return math.Sin(x), nil
+		}, 0.5, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rootX, err := root.FindHalleyFD(tc.f, tc.x0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if 1e-6*math.Max(1, math.Abs(tc.root)) < math.Abs(rootX-tc.root) {
+				t.Errorf("not valid root: %.16e", rootX)
+			}
+		})
+	}
+	t.Run("no root", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return x*x + 1, nil
+		}
+		_, err := root.FindHalleyFD(f, 1)
+		t.Logf("%v", err)
+		if err == nil {
+			t.Fatalf("root is found")
+		}
+	})
+	t.Run("NaN", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return math.Sqrt(x) - 2, nil
+		}
+		_, err := root.FindHalleyFD(f, -1)
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.NotValidValue {
+			t.Fatalf("not valid error: %v", err)
+		}
+	})
+}
+
+func TestFindNewtonFused(t *testing.T) {
+	var calls int
+	fd := func(x float64) (float64, float64, error) {
+		calls++
+		return math.Atan(x), 1.0 / (1.0 + x*x), nil
+	}
+	rootX, err := root.FindNewtonFused(fd, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root.Precision < math.Abs(rootX) {
+		t.Errorf("not valid root: %e", rootX)
+	}
+	fused := calls
+	calls = 0
+	f := func(x float64) (float64, error) {
+		calls++
+		return math.Atan(x), nil
+	}
+	df := func(x float64) (float64, error) {
+		calls++
+		return 1.0 / (1.0 + x*x), nil
+	}
+	expect, err := root.FindDampedNewton(f, df, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("calls: fused %d, separate %d", fused, calls)
+	if calls <= fused {
+		t.Errorf("amount of calls is not less")
+	}
+	if rootX != expect {
+		t.Errorf("not same root: %e != %e", rootX, expect)
+	}
+	t.Run("no root", func(t *testing.T) {
+		g := func(x float64) (float64, float64, error) {
+			return x*x + 1, 2 * x, nil
+		}
+		_, err := root.FindNewtonFused(g, 1)
+		t.Logf("%v", err)
+		if err == nil {
+			t.Fatalf("root is found")
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package root
+
+import "fmt"
+
+// FindPolynomial finds real root of polynomial on interval [minX, maxX]
+// by Find. Polynomial is calculated by Horner's method:
+//
+//	p(x) = coeffs[0]*x^n + coeffs[1]*x^(n-1) + ... + coeffs[n]
+//
+//	Input data:
+//		coeffs - coefficients of polynomial from the highest degree
+//		minX   - minimal X
+//		maxX   - maximal X
+//	Output data:
+//		root   - root of polynomial
+//		err    - error if some is not ok
+//
+// Leading zero coefficients are ignored. If degree of polynomial is
+// zero or all coefficients are zero, then returns ErrorFind with type
+// NotValidValue.
+//
+// Notes:
+//   - Concurrency acceptable
+//   - Panic-free function
+func FindPolynomial(coeffs []float64, minX, maxX float64) (root float64, err error) {
+	// ignore leading zeros
+	for 0 < len(coeffs) && coeffs[0] == 0 {
+		coeffs = coeffs[1:]
+	}
+	if len(coeffs) < 2 {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("degree of polynomial is not valid: %d", len(coeffs)-1),
+		}
+		return
+	}
+	p := func(x float64) (float64, error) {
+		return horner(coeffs, x), nil
+	}
+	return Find(p, minX, maxX)
+}
+
+// horner returns value of polynomial by Horner's method
+func horner(coeffs []float64, x float64) (y float64) {
+	for _, c := range coeffs {
+		y = y*x + c
+	}
+	return
+}
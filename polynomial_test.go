@@ -0,0 +1,38 @@
+package root_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindPolynomial(t *testing.T) {
+	for _, tc := range []struct {
+		coeffs     []float64
+		minX, maxX float64
+		root       float64
+	}{
+		{[]float64{1, 0, -2}, 0, 2, math.Sqrt2},
+		{[]float64{1, -2, -5}, 3, 4, 1 + math.Sqrt(6)},
+		{[]float64{0, 0, 1, 0, -2, -5}, 2, 3, 2.0945514815423265},
+		{[]float64{2, -3}, 0, 10, 1.5},
+	} {
+		rootX, err := root.FindPolynomial(tc.coeffs, tc.minX, tc.maxX)
+		if err != nil {
+			t.Fatalf("%v: %v", tc.coeffs, err)
+		}
+		if 1e-5 < math.Abs(rootX-tc.root) {
+			t.Errorf("%v: not valid root: %e != %e", tc.coeffs, rootX, tc.root)
+		}
+	}
+	for _, coeffs := range [][]float64{nil, {0, 0}, {0, 3}} {
+		_, err := root.FindPolynomial(coeffs, 0, 1)
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.NotValidValue {
+			t.Errorf("%v: not valid error: %v", coeffs, err)
+		}
+	}
+}
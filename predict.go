@@ -0,0 +1,56 @@
+package root
+
+import "math"
+
+// PredictIterations returns amount of bisection iterations for
+// reducing width of interval [minX, maxX] less precision:
+//
+//	(maxX-minX)/2^n < precision
+//
+// Precision is absolute, so for interval with |x| > 1 the prediction
+// is upper bound of iterations of Find with relative precision.
+// Iterations for reducing absolute value of function are not taken
+// into account. For not valid input data returns -1.
+func PredictIterations(minX, maxX, precision float64) int {
+	width := math.Abs(maxX - minX)
+	if !(0 < precision) || math.IsInf(precision, 0) ||
+		math.IsNaN(width) || math.IsInf(width, 0) {
+		return -1
+	}
+	if width < precision {
+		return 0
+	}
+	return int(math.Floor(math.Log2(width/precision))) + 1
+}
+
+// PredictEvaluations returns amount of function calls of Find for
+// bisection. Amount includes three calls at the start of searching
+// for borders and middle of interval and the last call for the root.
+// For not valid input data returns -1.
+func PredictEvaluations(minX, maxX, precision float64) int {
+	n := PredictIterations(minX, maxX, precision)
+	if n < 0 {
+		return n
+	}
+	return n + 4
+}
+
+// IterationsNeeded returns amount of bisection iterations for reducing
+// width of interval not more precision:
+//
+//	n = ceil(log2(width/precision))
+//
+// It is useful for allocation of buffers before searching. Result is
+// same as PredictIterations, except width/precision is exact power
+// of 2. For not valid input data returns -1.
+func IterationsNeeded(width, precision float64) int {
+	width = math.Abs(width)
+	if !(0 < precision) || math.IsInf(precision, 0) ||
+		math.IsNaN(width) || math.IsInf(width, 0) {
+		return -1
+	}
+	if width <= precision {
+		return 0
+	}
+	return int(math.Ceil(math.Log2(width / precision)))
+}
@@ -0,0 +1,73 @@
+package root_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestPredictIterations(t *testing.T) {
+	for _, r := range []float64{1.1, 1.3, 1.5, math.Sqrt2, 1.9} {
+		f := func(x float64) (float64, error) {
+			return (x*x - r*r) / 4, nil
+		}
+		res, err := root.FindResult(f, 1, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var (
+			iters = root.PredictIterations(1, 2, root.Precision)
+			evals = root.PredictEvaluations(1, 2, root.Precision)
+		)
+		t.Logf("root %.3f: iterations %d of %d, evaluations %d of %d",
+			r, res.Iterations, iters, res.Evaluations, evals)
+		// relative precision for interval [1, 2] is less one bit
+		// different from absolute precision
+		if res.Iterations < iters-1 || iters < res.Iterations {
+			t.Errorf("not valid prediction of iterations: %d != %d",
+				res.Iterations, iters)
+		}
+		if res.Evaluations < evals-1 || evals < res.Evaluations {
+			t.Errorf("not valid prediction of evaluations: %d != %d",
+				res.Evaluations, evals)
+		}
+	}
+	if n := root.PredictIterations(0, 1, 0); n != -1 {
+		t.Errorf("not valid prediction for zero precision: %d", n)
+	}
+	if n := root.PredictIterations(0, 1e-9, 1e-6); n != 0 {
+		t.Errorf("not valid prediction for narrow interval: %d", n)
+	}
+}
+
+func TestIterationsNeeded(t *testing.T) {
+	for _, tc := range []struct {
+		width, precision float64
+		n                int
+	}{
+		// typically for precision 1e-6 need 20 iterations
+		{1, 1e-6, 20},
+		{1, 0.25, 2},
+		{1, 0.3, 2},
+		{-1, 0.3, 2},
+		{1e-9, 1e-6, 0},
+		{1, 0, -1},
+		{math.NaN(), 1e-6, -1},
+		{math.Inf(1), 1e-6, -1},
+	} {
+		if n := root.IterationsNeeded(tc.width, tc.precision); n != tc.n {
+			t.Errorf("not valid amount for %e and %e: %d != %d",
+				tc.width, tc.precision, n, tc.n)
+		}
+	}
+	for _, prec := range []float64{1e-3, 1e-6, 1e-9} {
+		n := root.IterationsNeeded(1, prec)
+		if 1/math.Pow(2, float64(n)) > prec || 1/math.Pow(2, float64(n-1)) <= prec {
+			t.Errorf("amount is not minimal for %e: %d", prec, n)
+		}
+		if p := root.PredictIterations(0, 1, prec); p != n {
+			t.Errorf("not same with prediction for %e: %d != %d", prec, p, n)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// FindResumable returns function for root-finding by bisection step by
+// step. Each call of step runs one iteration of searching and returns
+// current estimation of root. The first call runs function for borders
+// and middle of interval. When searching is finished, the done is true
+// and next calls return the same result.
+//
+//	Input data:
+//		f    - function of variable X for root-finding
+//		minX - minimal X
+//		maxX - maximal X
+//	Output data:
+//		step - function of one iteration of searching
+//
+// Result of searching is same as Find.
+//
+// Notes:
+//   - Function step is panic-free
+func FindResumable[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64) (step func() (root F64, done bool, err error)) {
+	g := func(x float64) (float64, error) {
+		y, err := f(F64(x))
+		return float64(y), err
+	}
+	var (
+		xLeft, xRigth        = float64(minX), float64(maxX)
+		xRoot                float64
+		yLeft, yRoot, yRigth float64
+
+		prec, maxIter = parameters()
+
+		iter     int
+		started  bool
+		finished bool
+		result   float64
+		errRes   error
+	)
+	// finish stores result of searching
+	finish := func(x float64, err error) {
+		finished = true
+		result, errRes = x, err
+		if err == nil {
+			_, errRes = g(x)
+		}
+	}
+	// setup runs function for borders and middle of interval
+	setup := func() {
+		if err := checkPrecision(prec); err != nil {
+			finish(0, err)
+			return
+		}
+		if xLeft > xRigth {
+			xLeft, xRigth = xRigth, xLeft
+		}
+		xRoot = xLeft + (xRigth-xLeft)/2.0
+		var err error
+		if yLeft, err = g(xLeft); err != nil {
+			finish(0, err)
+			return
+		}
+		if yRoot, err = g(xRoot); err != nil {
+			finish(0, err)
+			return
+		}
+		if yRigth, err = g(xRigth); err != nil {
+			finish(0, err)
+			return
+		}
+		if math.Abs(yLeft) < prec {
+			finish(xLeft, nil)
+			return
+		}
+		if math.Abs(yRigth) < prec {
+			finish(xRigth, nil)
+		}
+	}
+	// next runs one iteration of bisection
+	next := func() {
+		if iter >= maxIter {
+			finish(0, ErrorFind{
+				Type: MaximalIteration,
+				Err:  fmt.Errorf("Too many iterations: %d", iter),
+			})
+			return
+		}
+		iter++
+		var width bool
+		if xLeft == 0 {
+			width = math.Abs(xRigth-xLeft) < prec
+		} else {
+			width = math.Abs((xRigth-xLeft)/xLeft) < prec
+		}
+		if (math.Abs(yRoot) < prec && width) ||
+			xRigth-xLeft <= math.Max(MinInterval(xLeft), MinInterval(xRigth)) {
+			finish(xRoot, nil)
+			return
+		}
+		// zero value of function is related to the right part of
+		// interval for finding the leftmost root of flat zero region
+		if yRoot == 0 || math.Signbit(yLeft) != math.Signbit(yRoot) {
+			xRigth, yRigth = xRoot, yRoot
+		} else if yRigth == 0 || math.Signbit(yRoot) != math.Signbit(yRigth) {
+			xLeft, yLeft = xRoot, yRoot
+		} else {
+			finish(0, ErrorFind{
+				Type: InternalErr,
+				Err: NoBracketError{
+					XLeft: xLeft, XRight: xRigth,
+					YLeft: yLeft, YRight: yRigth,
+				},
+			})
+			return
+		}
+		xRoot = xLeft + (xRigth-xLeft)/2.0
+		var err error
+		if yRoot, err = g(xRoot); err != nil {
+			finish(0, ErrorFind{
+				Type: InternalErr,
+				Err:  err,
+			})
+			return
+		}
+		if err = checkValues(xRoot, yRoot); err != nil {
+			finish(0, err)
+		}
+	}
+	return func() (root F64, done bool, err error) {
+		// recovering
+		defer func() {
+			if r := recover(); r != nil {
+				finished = true
+				result, errRes = 0, ErrorFind{
+					Type: Recovery,
+					Err:  fmt.Errorf("%#v", r),
+				}
+				root, done, err = 0, true, errRes
+			}
+		}()
+		if !finished {
+			if started {
+				next()
+			} else {
+				started = true
+				setup()
+			}
+		}
+		if finished {
+			return F64(result), true, errRes
+		}
+		return F64(xRoot), false, nil
+	}
+}
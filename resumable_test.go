@@ -0,0 +1,49 @@
+package root_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindResumable(t *testing.T) {
+	for i := range tcs {
+		t.Run(fmt.Sprintf("Case%3d", i), func(t *testing.T) {
+			f := func(x float64) (float64, error) {
+				return tcs[i].f(x), nil
+			}
+			expect, errExpect := root.Find(f, tcs[i].Xmin, tcs[i].Xmax)
+			step := root.FindResumable(f, tcs[i].Xmin, tcs[i].Xmax)
+			var (
+				rootX float64
+				done  bool
+				err   error
+				steps int
+			)
+			for !done {
+				rootX, done, err = step()
+				steps++
+			}
+			if (err == nil) != (errExpect == nil) {
+				t.Fatalf("not same errors: %v != %v", err, errExpect)
+			}
+			if rootX != expect {
+				t.Errorf("not same root: %.16e != %.16e", rootX, expect)
+			}
+			// result is not changed after finishing
+			if again, done, _ := step(); !done || again != rootX {
+				t.Errorf("result is changed")
+			}
+			t.Logf("steps: %d", steps)
+		})
+	}
+	t.Run("panic", func(t *testing.T) {
+		step := root.FindResumable(func(x float64) (float64, error) {
+			panic("panic")
+		}, 0, 1)
+		if _, done, err := step(); !done || err == nil {
+			t.Errorf("panic is not recovered")
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// FindRidders finds root of function by Ridders' method. On each
+// iteration function is run in the middle of interval and the next
+// point is found by exponential interpolation. Root is always
+// bracketed.
+//
+// Documentation: https://en.wikipedia.org/wiki/Ridders%27_method
+//
+//	Input data:
+//		f    - function of variable X for root-finding
+//		minX - minimal X
+//		maxX - maximal X
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// Function values on borders must have opposite signs, otherwise
+// returns ErrorFind with type InternalErr and wrapped ErrNoSignChange.
+// Convergence is checked same as in Find. If interval cannot be splitted
+// in float64 arithmetic, but absolute value of function is not less
+// Precision, then returns ErrorFind with type Discontinuity.
+//
+// Notes:
+//   - Panic-free function
+func FindRidders(f func(float64) (float64, error), minX, maxX float64) (root float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	var (
+		prec, maxIter = parameters()
+
+		xLeft, xRigth = minX, maxX
+		yLeft, yRigth float64
+	)
+	if err = checkPrecision(prec); err != nil {
+		return
+	}
+	// replace borders
+	if xLeft > xRigth {
+		xLeft, xRigth = xRigth, xLeft
+	}
+	if yLeft, err = f(xLeft); err != nil {
+		return
+	}
+	if yRigth, err = f(xRigth); err != nil {
+		return
+	}
+	if math.Abs(yLeft) < prec {
+		return xLeft, nil
+	}
+	if math.Abs(yRigth) < prec {
+		return xRigth, nil
+	}
+	if math.Signbit(yLeft) == math.Signbit(yRigth) {
+		err = ErrorFind{
+			Type: InternalErr,
+			Err: fmt.Errorf("%w: [%s, %s]",
+				ErrNoSignChange, fmtFloat(yLeft), fmtFloat(yRigth)),
+		}
+		return
+	}
+	var xRoot, yRoot float64
+	for iter := 0; ; iter++ {
+		if iter >= maxIter {
+			err = ErrorFind{
+				Type: MaximalIteration,
+				Err:  fmt.Errorf("Too many iterations: %d", iter),
+			}
+			return
+		}
+		xMiddle := xLeft + (xRigth-xLeft)/2.0
+		var yMiddle float64
+		if yMiddle, err = f(xMiddle); err != nil {
+			return
+		}
+		if err = checkValues(xMiddle, yMiddle); err != nil {
+			return
+		}
+		s := math.Sqrt(yMiddle*yMiddle - yLeft*yRigth)
+		if s == 0 {
+			xRoot, yRoot = xMiddle, yMiddle
+			break
+		}
+		sign := 1.0
+		if yLeft < yRigth {
+			sign = -1.0
+		}
+		xRoot = xMiddle + (xMiddle-xLeft)*sign*yMiddle/s
+		if yRoot, err = f(xRoot); err != nil {
+			return
+		}
+		if err = checkValues(xRoot, yRoot); err != nil {
+			return
+		}
+		// reduce interval
+		switch {
+		case math.Signbit(yMiddle) != math.Signbit(yRoot):
+			if xMiddle < xRoot {
+				xLeft, yLeft, xRigth, yRigth = xMiddle, yMiddle, xRoot, yRoot
+			} else {
+				xLeft, yLeft, xRigth, yRigth = xRoot, yRoot, xMiddle, yMiddle
+			}
+		case math.Signbit(yLeft) != math.Signbit(yRoot):
+			xRigth, yRigth = xRoot, yRoot
+		default:
+			xLeft, yLeft = xRoot, yRoot
+		}
+		if yRoot == 0 || (math.Abs(yRoot) < prec && converged(xRigth-xLeft, xRoot, prec)) {
+			break // find the solution
+		}
+		if xRigth-xLeft <= math.Max(MinInterval(xLeft), MinInterval(xRigth)) {
+			// interval cannot be splitted in float64 arithmetic
+			if prec <= math.Abs(yRoot) {
+				root = xRoot
+				err = ErrorFind{
+					Type: Discontinuity,
+					Err: fmt.Errorf("sign change without root: |f(%s)| = %s",
+						fmtFloat(xRoot), fmtFloat(math.Abs(yRoot))),
+				}
+				return
+			}
+			break
+		}
+	}
+	root = xRoot
+	return
+}
@@ -0,0 +1,69 @@
+package root_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindRidders(t *testing.T) {
+	var ridders, bisection int
+	for i := range tcs {
+		t.Run(fmt.Sprintf("Case%3d", i), func(t *testing.T) {
+			f := func(x float64) (float64, error) {
+				ridders++
+				return tcs[i].f(x), nil
+			}
+			rootX, err := root.FindRidders(f, tcs[i].Xmin, tcs[i].Xmax)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rootX < tcs[i].Xmin || tcs[i].Xmax < rootX {
+				t.Errorf("not valid root")
+			}
+			if root.Precision < math.Abs(tcs[i].f(rootX)) {
+				t.Errorf("not valid precision: %e", math.Abs(tcs[i].f(rootX)))
+			}
+			res, err := root.FindResult(fixtureFunc(tcs[i].f), tcs[i].Xmin, tcs[i].Xmax)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bisection += res.Evaluations
+		})
+	}
+	t.Logf("Average amount of calls: Ridders %.2f, bisection %.2f",
+		float64(ridders)/float64(len(tcs)), float64(bisection)/float64(len(tcs)))
+	if bisection <= ridders {
+		t.Errorf("Ridders' method is not faster")
+	}
+	t.Run("no sign change", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return x*x + 1, nil
+		}
+		_, err := root.FindRidders(f, -1, 2)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.InternalErr || !errors.Is(err, root.ErrNoSignChange) {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+	t.Run("jump", func(t *testing.T) {
+		jump := func(x float64) (float64, error) {
+			if x < 0.3 {
+				return -1, nil
+			}
+			return 1, nil
+		}
+		rootX, err := root.FindRidders(jump, 0, 1)
+		t.Logf("%e %v", rootX, err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.Discontinuity {
+			t.Errorf("not valid error: %v", err)
+		}
+		if 1e-5 < math.Abs(rootX-0.3) {
+			t.Errorf("not valid jump: %e", rootX)
+		}
+	})
+}
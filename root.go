@@ -1,21 +1,27 @@
 package root
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"math"
+	"sync"
+	"time"
 )
 
 // Constants
 var (
-	// Precision of rott-finding
+	// Precision of rott-finding.
+	// Use SetPrecision for change concurrently with root-finding.
 	Precision float64 = 1e-6
 
 	// MaxIteration is max allowable amount of iteration.
 	// Typically for precition=1e-6 need 20 iterations.
+	// Use SetMaxIteration for change concurrently with root-finding.
 	//
-	// Example:
+	// Example for function of Case 26 on interval [0, 1]:
 	//
-	//	go test -v -run="Test/Case_26"
+	//	root.FindWith(root.Config{Trace: os.Stdout, TableFormat: true}, f, 0, 1)
 	//
 	//	It.  X value         Y value         Xerror
 	//  0    5.000000e-01    5.714286e-03    1.000000e+00
@@ -42,15 +48,154 @@ var (
 	MaxIteration int = 500
 )
 
+// settings guards Precision, MaxIteration and MaxAbs for reconfiguration
+// concurrently with root-finding
+var settings sync.RWMutex
+
+// SetPrecision sets package variable Precision. Function is safe for
+// calls concurrently with root-finding.
+func SetPrecision(prec float64) error {
+	if err := checkPrecision(prec); err != nil {
+		return err
+	}
+	settings.Lock()
+	Precision = prec
+	settings.Unlock()
+	return nil
+}
+
+// SetMaxIteration sets package variable MaxIteration. Function is safe
+// for calls concurrently with root-finding.
+func SetMaxIteration(maxIter int) error {
+	if maxIter < 1 {
+		return ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("max iteration is not valid: %d", maxIter),
+		}
+	}
+	settings.Lock()
+	MaxIteration = maxIter
+	settings.Unlock()
+	return nil
+}
+
+// parameters returns package variables Precision and MaxIteration
+func parameters() (prec float64, maxIter int) {
+	settings.RLock()
+	prec, maxIter = Precision, MaxIteration
+	settings.RUnlock()
+	return
+}
+
+// DefaultPrecision returns precision of root-finding for type F as
+// square root of machine epsilon of type. For float64 value is near
+// 1.5e-8, that is tighter than default value of Precision.
+//
+// Example of usage:
+//
+//	root.FindWith(root.Config{Precision: root.DefaultPrecision[float64]()}, f, 0, 1)
+//
+// Default value of Config.Precision is package variable Precision and
+// is not changed by that function.
+func DefaultPrecision[F ~float64]() F {
+	one := F(1)
+	eps := F(math.Nextafter(float64(one), 2)) - one
+	return F(math.Sqrt(float64(eps)))
+}
+
 type ErrorFind struct {
 	Type ErrType
 	Err  error
+
+	// interval of searching
+	bounded    bool
+	minX, maxX float64
+	format     ErrorFormat
 }
 
 func (e ErrorFind) Error() string {
-	return fmt.Sprintf("%s:%s", e.Type, e.Err)
+	if !e.bounded {
+		return fmt.Sprintf("%s:%s", e.Type, e.Err)
+	}
+	bounds := fmt.Sprintf("[%s,%s]", fmtFloat(e.minX), fmtFloat(e.maxX))
+	if e.format == Terse {
+		return fmt.Sprintf("%s %s", e.Type, bounds)
+	}
+	return fmt.Sprintf("Cannot find %s: %s:%s", bounds, e.Type, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e ErrorFind) Unwrap() error {
+	return e.Err
+}
+
+// ErrorFloatFormat is format of float values in error messages.
+// For example, "%.17g" is format without loss of precision.
+var ErrorFloatFormat = "%.3e"
+
+// fmtFloat returns float value formatted by ErrorFloatFormat for
+// error messages
+func fmtFloat[F ~float64](v F) string {
+	return fmt.Sprintf(ErrorFloatFormat, float64(v))
+}
+
+// ErrorFormat is format of error message
+type ErrorFormat int8
+
+const (
+	// Detailed error message with interval of searching and
+	// description of error
+	Detailed ErrorFormat = iota
+
+	// Terse error message with interval of searching and
+	// type of error only
+	Terse
+)
+
+// bound adds interval of searching into error
+func bound(format ErrorFormat, err error, minX, maxX float64) error {
+	e, ok := err.(ErrorFind)
+	if !ok {
+		e = ErrorFind{
+			Type: InternalErr,
+			Err:  err,
+		}
+	}
+	e.bounded = true
+	e.minX, e.maxX = minX, maxX
+	e.format = format
+	return e
 }
 
+// ErrNoSignChange is returned, wrapped into ErrorFind, when the function
+// have not changed sign on the searched interval.
+var ErrNoSignChange = errors.New("no sign change")
+
+// NoBracketError is returned, wrapped into ErrorFind with type
+// InternalErr, when the function have same sign on borders of interval.
+// Error unwraps to ErrNoSignChange.
+type NoBracketError struct {
+	// borders of interval
+	XLeft, XRight float64
+
+	// function values on borders of interval
+	YLeft, YRight float64
+}
+
+func (e NoBracketError) Error() string {
+	return fmt.Sprintf("No root: f(%s) = %s, f(%s) = %s",
+		fmtFloat(e.XLeft), fmtFloat(e.YLeft), fmtFloat(e.XRight), fmtFloat(e.YRight))
+}
+
+func (e NoBracketError) Unwrap() error {
+	return ErrNoSignChange
+}
+
+// ErrConstant is returned, wrapped into ErrorFind, when the function
+// have same values, within Precision, on borders and in the middle
+// of interval.
+var ErrConstant = errors.New("function appears constant")
+
 type ErrType int8
 
 const (
@@ -58,6 +203,10 @@ const (
 	InternalErr
 	NotValidValue
 	Recovery
+	Cancelled
+	MultipleRoots
+	Stalled
+	Discontinuity
 )
 
 func (et ErrType) String() string {
@@ -70,6 +219,14 @@ func (et ErrType) String() string {
 		return "not valid value"
 	case Recovery:
 		return "recovery"
+	case Cancelled:
+		return "cancelled"
+	case MultipleRoots:
+		return "multiple roots"
+	case Stalled:
+		return "stalled"
+	case Discontinuity:
+		return "discontinuity"
 	}
 	return "undefined"
 }
@@ -96,8 +253,630 @@ func (et ErrType) String() string {
 //   - Concurrency acceptable
 //   - Panic-free function
 //
-// Last operation of finding is run function.
+// Last operation of finding is run function. For skipping of the last
+// function call use FindWith with FinalCall Never.
+//
+// If function have flat region with zero values, then the leftmost
+// X value of region is returned.
+//
+// If function value in the middle of interval is average of values on
+// borders within Precision, then function is linear and the root is
+// found by linear interpolation without iterations.
+//
+// If next point of searching is not moved on few iterations, then
+// returns ErrorFind with type Stalled and X value with minimal absolute
+// value of function as root.
+//
+// If interval cannot be splitted in float64 arithmetic, but absolute
+// value of function is not less Precision, then function is
+// discontinuous or Precision is not achievable, so returns ErrorFind
+// with type Discontinuity and X value of jump as root.
 func Find[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64) (root F64, err error) {
+	prec, maxIter := parameters()
+	return find(Config{
+		Precision:    prec,
+		MaxIteration: maxIter,
+	}, f, minX, maxX, nil)
+}
+
+// FindWith is same as Find, but with specific configuration.
+// Find is same as FindWith with configuration from package variables
+// Precision and MaxIteration. Configuration is independent for each
+// call, so concurrent calls with different configurations are safe.
+func FindWith[F64 ~float64, F64R ~float64](cfg Config, f func(F64) (F64R, error), minX, maxX F64) (root F64, err error) {
+	cfg, f = prepare(cfg, f)
+	return find(cfg, f, minX, maxX, nil)
+}
+
+// prepare returns configuration and function with log of function
+// calls and function for concurrent run
+func prepare[F64 ~float64, F64R ~float64](cfg Config, f func(F64) (F64R, error)) (Config, func(F64) (F64R, error)) {
+	if cfg.EvalLog != nil {
+		var (
+			mutex sync.Mutex
+			log   = cfg.EvalLog
+			fLog  = f
+		)
+		f = func(x F64) (F64R, error) {
+			y, err := fLog(x)
+			mutex.Lock()
+			*log = append(*log, EvalRecord{X: float64(x), Y: float64(y), Err: err})
+			mutex.Unlock()
+			return y, err
+		}
+	}
+	if cfg.ConcurrentEval {
+		// function for concurrent run is prepared here, because
+		// escaping of function to heap is not acceptable for Find
+		cfg.concurrent = func(x float64) (float64, error) {
+			y, err := f(F64(x))
+			return float64(y), err
+		}
+	}
+	return cfg, f
+}
+
+// EvalRecord is record of function call
+type EvalRecord struct {
+	X, Y float64
+	Err  error
+}
+
+// Config of root-finding.
+// Zero value of field is replaced by default value.
+type Config struct {
+	// Precision of root-finding.
+	// Default value is package variable Precision.
+	Precision float64
+
+	// MaxIteration is max allowable amount of iteration.
+	// Default value is package variable MaxIteration.
+	MaxIteration int
+
+	// Method of root-finding.
+	// Default method is Bisection.
+	Method Method
+
+	// Midpoint returns next point of searching for interval with
+	// function values on borders. If not nil, then it is used instead
+	// of Method. If point is not strictly inside of interval, then
+	// bisection is used, so the root is always bracketed.
+	Midpoint func(xLeft, yLeft, xRigth, yRigth float64) float64
+
+	// MinSlope is minimal absolute slope of line between borders of
+	// interval, used by interpolation methods. If slope is less, then
+	// bisection is used for that iteration.
+	// Default value is Precision.
+	MinSlope float64
+
+	// StrictBounds is true, when minX more maxX is error.
+	// By default, borders are swapped.
+	StrictBounds bool
+
+	// FinalCall is behavior of the last operation of finding.
+	// By default, function is run for the root.
+	FinalCall FinalCall
+
+	// ReturnBest is true, when X value with minimal absolute value of
+	// function from all function calls is returned as root.
+	ReturnBest bool
+
+	// TerminateOnWidthOnly is true, when searching is stopped only by
+	// width of interval without checking of function value.
+	// Absolute value of function in the root may be not small.
+	TerminateOnWidthOnly bool
+
+	// SafeMode is true, when on each iteration the new interval is
+	// checked to be strict subset of previous interval. It is
+	// internal consistency check for debugging.
+	SafeMode bool
+
+	// BothEndpointsRoots is behavior, when absolute values of
+	// function on both borders of interval are less Precision.
+	BothEndpointsRoots EndpointsRoots
+
+	// EvalLog is log of all function calls in order of calls.
+	// Record is appended for each call, so memory is allocated.
+	EvalLog *[]EvalRecord
+
+	// History is true, when all function calls are stored in field
+	// History of result. It is used only by FindResultWith.
+	History bool
+
+	// Trace is writer for state of each iteration
+	Trace io.Writer
+
+	// TableFormat is true, when Trace is written by table with
+	// relative width of interval. Format of table is same as in the
+	// documentation of MaxIteration.
+	TableFormat bool
+
+	// OnStep is called on each iteration with the middle of interval,
+	// value of function in it and relative width of interval, same
+	// as columns of table in the documentation of MaxIteration.
+	OnStep func(iter int, x, y, xerr float64)
+
+	// ConcurrentEval is true, when the function is run concurrently
+	// for the borders and the middle of interval at the start of
+	// searching. Function must be safe for concurrent calls.
+	ConcurrentEval bool
+
+	// ScaleByEndpoints is true, when absolute value of function in
+	// the root is compared with Precision multiplied by geometric mean
+	// of absolute values of function on borders of interval.
+	// Function values on borders are scale of function, so the
+	// searching is independent from units of function.
+	ScaleByEndpoints bool
+
+	// AbsTol and RelTol are absolute and relative tolerances of X.
+	// Searching is converged, when
+	//
+	//	|xRigth-xLeft| <= AbsTol + RelTol*|xRoot|
+	//
+	// If both are zero, then relative tolerance Precision is used
+	// and absolute tolerance Precision for zero left border.
+	AbsTol, RelTol float64
+
+	// ToleranceFunc returns absolute tolerance of X for current
+	// estimation of root. Function is called on each iteration and
+	// must return positive finite value. If nil, then relative
+	// tolerance Precision is used.
+	ToleranceFunc func(x float64) float64
+
+	// Tolerance is set of stopping criteria. If not zero, then it is
+	// used instead of Precision, AbsTol, RelTol and ToleranceFunc.
+	Tolerance Tolerance
+
+	// Converged returns true, when searching is converged for the
+	// middle of interval, value of function in it and width of
+	// interval. If not nil, then it is used instead of all other
+	// stopping criteria and linear interpolation at the start of
+	// searching is not used. Checking of not valid values of function
+	// and maximal amount of iterations is not changed.
+	Converged func(iter int, x, y, width float64) bool
+
+	// ExpectedDirection is expected direction of sign change of
+	// function on interval. If detected direction is not same, then
+	// error NotValidValue is returned.
+	ExpectedDirection Direction
+
+	// PreScan is amount of samples uniformly spaced on interval for
+	// checking of sign changes before searching. If more one sign
+	// change is detected, then error MultipleRoots is returned.
+	// If zero, then interval is not scanned.
+	PreScan int
+
+	// AbortOnResidualIncrease is true, when searching is stopped with
+	// error, if absolute value of function is increased between
+	// iterations.
+	AbortOnResidualIncrease bool
+
+	// ErrorFormat is format of error message. Interval of searching
+	// is included in error message for any format.
+	ErrorFormat ErrorFormat
+
+	// concurrent is function for concurrent run
+	concurrent func(float64) (float64, error)
+
+	// lipschitz is Lipschitz constant of function
+	lipschitz float64
+
+	// iteration is run after function call on each iteration
+	iteration func(xLeft, xRoot, xRigth, yRoot float64)
+
+	// bisectOnly is true, when linear interpolation at the start
+	// of searching is not acceptable and final interval must be
+	// reduced by bisection
+	bisectOnly bool
+
+	// guess is X value of the first point of searching instead of
+	// the middle of interval, if guessed is true
+	guess   float64
+	guessed bool
+}
+
+// FinalCall is behavior of the last function call for the root
+type FinalCall int8
+
+const (
+	// Always run function for the root at the end of finding
+	Always FinalCall = iota
+
+	// Never run function for the root at the end of finding.
+	// It is useful for function with side effects, for example
+	// logging or counters. Root is X value of one of previous
+	// function calls, so value of function in the root is known
+	// from that call, but error of that call is not returned.
+	Never
+
+	// OnlyIfMoved run function for the root at the end of finding only
+	// if the last function call was for another X value
+	OnlyIfMoved
+)
+
+// defaults returns configuration with default values instead of zero values
+func (cfg Config) defaults() Config {
+	prec, maxIter := parameters()
+	if cfg.Precision == 0 {
+		cfg.Precision = prec
+	}
+	if cfg.MaxIteration == 0 {
+		cfg.MaxIteration = maxIter
+	}
+	if cfg.MinSlope == 0 {
+		cfg.MinSlope = cfg.Precision
+	}
+	return cfg
+}
+
+// evaluate runs function concurrently for each X value
+func evaluate(f func(float64) (float64, error), xs [3]float64) (ys [3]float64, errs [3]error) {
+	var wg sync.WaitGroup
+	for i := range xs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// recovering
+			defer func() {
+				if r := recover(); r != nil {
+					errs[i] = ErrorFind{
+						Type: Recovery,
+						Err:  fmt.Errorf("%#v", r),
+					}
+				}
+			}()
+			ys[i], errs[i] = f(xs[i])
+		}(i)
+	}
+	wg.Wait()
+	return
+}
+
+// checkPrecision returns error for not valid precision
+func checkPrecision(prec float64) error {
+	if !(0 < prec) || math.IsInf(prec, 0) {
+		return ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("precision must be positive: %s", fmtFloat(prec)),
+		}
+	}
+	return nil
+}
+
+// Tolerance is set of stopping criteria. Zero field is not used for
+// stopping. Searching is converged, when all used criteria are
+// satisfied:
+//
+//	|xRigth-xLeft| <= AbsX + RelX*|xRoot|
+//	|f(xRoot)|     <  AbsY
+//
+// For example, searching with only AbsY is stopped by residual
+// without checking of width of interval.
+type Tolerance struct {
+	// AbsX and RelX are absolute and relative tolerances of X
+	AbsX, RelX float64
+
+	// AbsY is absolute tolerance of function value
+	AbsY float64
+}
+
+// Method of root-finding
+type Method int8
+
+const (
+	// Bisection method
+	//
+	// Documentation: https://en.wikipedia.org/wiki/Bisection_method
+	Bisection Method = iota
+
+	// FalsePosition is regula falsi method. Next point of searching is
+	// the root of line between borders of interval.
+	//
+	// Documentation: https://en.wikipedia.org/wiki/Regula_falsi
+	FalsePosition
+
+	// Illinois is regula falsi method with Illinois modification.
+	// If the same border of interval is retained twice, then value of
+	// function on this border is halved for next point of searching.
+	//
+	// Documentation: https://en.wikipedia.org/wiki/Regula_falsi#The_Illinois_algorithm
+	Illinois
+
+	// AndersonBjorck is regula falsi method with Anderson-Bjorck
+	// modification. If the same border of interval is retained twice,
+	// then value of function on this border is multiplied by factor
+	//
+	//	m = 1 - y/yOld
+	//
+	// where y is value of function in the new border and yOld is value
+	// of function in the replaced border. If factor is not positive,
+	// then value is halved same as for Illinois.
+	//
+	// Documentation: https://en.wikipedia.org/wiki/Regula_falsi#Improvements_in_regula_falsi
+	AndersonBjorck
+)
+
+func (m Method) String() string {
+	switch m {
+	case Bisection:
+		return "bisection"
+	case FalsePosition:
+		return "false position"
+	case Illinois:
+		return "Illinois"
+	case AndersonBjorck:
+		return "Anderson-Bjorck"
+	}
+	return "undefined"
+}
+
+// EndpointsRoots is behavior, when both borders of interval are roots
+type EndpointsRoots int8
+
+const (
+	// ReturnLeft returns left border of interval
+	ReturnLeft EndpointsRoots = iota
+
+	// ReturnRight returns right border of interval
+	ReturnRight
+
+	// ReturnError returns error
+	ReturnError
+)
+
+// Direction of sign change of function
+type Direction int8
+
+const (
+	// Any direction of sign change
+	Any Direction = iota
+
+	// NegToPos is sign change from negative to positive values
+	// with increasing X
+	NegToPos
+
+	// PosToNeg is sign change from positive to negative values
+	// with increasing X
+	PosToNeg
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Any:
+		return "any"
+	case NegToPos:
+		return "negative to positive"
+	case PosToNeg:
+		return "positive to negative"
+	}
+	return "undefined"
+}
+
+// Result of root-finding with statistics of search
+type Result struct {
+	// Root of function
+	Root float64
+
+	// Iterations is amount of iterations
+	Iterations int
+
+	// AtEndpoint is true, when absolute value of function on the
+	// border of interval is less Precision and the border is returned
+	// as root without iterations. It may be sign of not valid interval.
+	AtEndpoint bool
+
+	// Evaluations is amount of function calls
+	Evaluations int
+
+	// DuplicateEvaluations is amount of function calls with X value
+	// equal, within few ULPs, to X value of one of previous calls.
+	DuplicateEvaluations int
+
+	// Err is error of root-finding
+	Err error
+
+	// BestX is X value with minimal absolute value of function
+	// from all function calls during searching
+	BestX float64
+
+	// BestResidual is absolute value of function in BestX
+	BestResidual float64
+
+	// Duration is wall-clock time of searching
+	Duration time.Duration
+
+	// FinalResidual is absolute value of function in Root.
+	// Value is NaN, if function is not calculated in Root.
+	FinalResidual float64
+
+	// EstimatedOrder is estimation of order of convergence by absolute
+	// values of function on the last three iterations:
+	//
+	//	q = log(|y[n]/y[n-1]|) / log(|y[n-1]/y[n-2]|)
+	//
+	// Order is 1 for linear convergence, 2 for quadratic convergence.
+	// Zero values of function are not used. Value is NaN, if amount
+	// of iterations is less 3 or order cannot be estimated.
+	EstimatedOrder float64
+
+	// Lower and Upper are borders of final interval of searching.
+	// Function changes sign on the interval or one of borders is
+	// a root, so the root is inside [Lower, Upper].
+	Lower, Upper float64
+
+	// History is all function calls in order of calls, if field
+	// History of configuration is true. Amount of records is not more
+	// MaxIteration, so the next function calls are not recorded.
+	History []EvalRecord
+
+	// function values on borders of final interval
+	yLeft, yRigth float64
+}
+
+// atEndpoint marks root on the border of interval
+func (res *Result) atEndpoint() {
+	if res != nil {
+		res.AtEndpoint = true
+	}
+}
+
+// FindResult is same as Find, but returns statistics of search.
+// Memory is allocated for statistics of function calls.
+func FindResult[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64) (res Result, err error) {
+	return findResult(Config{}, f, minX, maxX)
+}
+
+// FindResultWith is same as FindResult, but with specific
+// configuration. Statistics is calculated sequentially, so the field
+// ConcurrentEval is not used.
+func FindResultWith[F64 ~float64, F64R ~float64](cfg Config, f func(F64) (F64R, error), minX, maxX F64) (res Result, err error) {
+	cfg.ConcurrentEval = false
+	cfg, f = prepare(cfg, f)
+	return findResult(cfg, f, minX, maxX)
+}
+
+// findResult is root-finding with statistics
+func findResult[F64 ~float64, F64R ~float64](cfg Config, f func(F64) (F64R, error), minX, maxX F64) (res Result, err error) {
+	return statistics(cfg, f, func(g func(F64) (F64R, error), res *Result) (F64, error) {
+		return find(cfg, g, minX, maxX, res)
+	})
+}
+
+// statistics runs root-finding with statistics of function calls
+func statistics[F64 ~float64, F64R ~float64](cfg Config, f func(F64) (F64R, error), run func(g func(F64) (F64R, error), res *Result) (F64, error)) (res Result, err error) {
+	start := time.Now()
+	var probes, values []float64
+	if cfg.History {
+		res.History = make([]EvalRecord, 0, cfg.defaults().MaxIteration)
+	}
+	g := func(x F64) (F64R, error) {
+		res.Evaluations++
+		for _, p := range probes {
+			if isSame(p, float64(x)) {
+				res.DuplicateEvaluations++
+				break
+			}
+		}
+		y, err := f(x)
+		probes = append(probes, float64(x))
+		values = append(values, math.Abs(float64(y)))
+		if len(res.History) < cap(res.History) {
+			res.History = append(res.History,
+				EvalRecord{X: float64(x), Y: float64(y), Err: err})
+		}
+		return y, err
+	}
+	root, err := run(g, &res)
+	res.Root = float64(root)
+	res.FinalResidual = math.NaN()
+	for i := len(probes) - 1; 0 <= i; i-- {
+		if probes[i] == res.Root {
+			res.FinalResidual = values[i]
+			break
+		}
+	}
+	res.Err = err
+	res.Duration = time.Since(start)
+	return
+}
+
+// FindWithSlope is same as Find, but also returns slope of function
+// on the final interval of searching:
+//
+//	slope = (yRigth-yLeft)/(xRigth-xLeft)
+//
+// Slope is estimation of function derivative in the root.
+// Units of slope are units of function divided by units of X.
+func FindWithSlope[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64) (root F64, slope float64, err error) {
+	var res Result
+	if root, err = find(Config{}, f, minX, maxX, &res); err != nil {
+		return
+	}
+	slope = (res.yRigth - res.yLeft) / (res.Upper - res.Lower)
+	return
+}
+
+// FindResidualHistory is same as Find, but also returns absolute
+// values of function for each iteration.
+// Plateau of residuals with decreasing width of interval is sign of
+// near-singular root.
+func FindResidualHistory[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64) (root F64, residuals []float64, err error) {
+	cfg := Config{
+		iteration: func(_, _, _, yRoot float64) {
+			residuals = append(residuals, math.Abs(yRoot))
+		},
+	}
+	root, err = find(cfg, f, minX, maxX, nil)
+	return
+}
+
+// FindLipschitz is same as Find, but for function with known
+// Lipschitz constant L:
+//
+//	|f(x1)-f(x2)| <= L*|x1-x2|
+//
+// Finding is stopped, when L*(xRigth-xLeft) < Precision, because in
+// that case absolute value of function in any point of interval is
+// less Precision. So, the function is not run for the last middle point,
+// except the last operation of finding.
+// Units of L are units of function divided by units of X.
+func FindLipschitz[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64, L float64) (root F64, err error) {
+	if !(0 < L) || math.IsInf(L, 0) {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("Lipschitz constant must be positive: %s", fmtFloat(L)),
+		}
+		return
+	}
+	return find(Config{lipschitz: L}, f, minX, maxX, nil)
+}
+
+// FindWithGuess is same as Find, but the first point of searching is
+// guess instead of the middle of interval. Next points of searching
+// are found by bisection. If guess is on the border of interval, then
+// the middle of interval is used.
+//
+//	Input data:
+//		f     - function of variable X for root-finding
+//		minX  - minimal X
+//		maxX  - maximal X
+//		guess - estimation of root inside interval [minX, maxX]
+//	Output data:
+//		root  - X value of root
+//		err   - error if some is not ok
+//
+// If guess is outside of interval, then returns ErrorFind with type
+// NotValidValue.
+func FindWithGuess[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX, guess F64) (root F64, err error) {
+	return find(Config{
+		guess:      float64(guess),
+		guessed:    true,
+		bisectOnly: true,
+	}, f, minX, maxX, nil)
+}
+
+// sameULPs is amount of ULPs for comparing X values
+const sameULPs = 4
+
+// isSame return true, if values are equal within few ULPs
+func isSame(a, b float64) bool {
+	if a == b {
+		return true
+	}
+	ulp := math.Max(
+		math.Abs(math.Nextafter(a, math.Inf(1))-a),
+		math.Abs(math.Nextafter(b, math.Inf(1))-b),
+	)
+	return math.Abs(a-b) <= sameULPs*ulp
+}
+
+// find is safe generic wrapper of solve
+func find[F64 ~float64, F64R ~float64](cfg Config, f func(F64) (F64R, error), minX, maxX F64, res *Result) (root F64, err error) {
+	defer func() {
+		if err != nil {
+			err = bound(cfg.ErrorFormat, err, float64(minX), float64(maxX))
+		}
+	}()
 	// recovering
 	defer func() {
 		if r := recover(); r != nil {
@@ -107,98 +886,523 @@ func Find[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64
 			}
 		}
 	}()
+	r, err := solve(cfg.defaults(), func(x float64) (float64, error) {
+		y, err := f(F64(x))
+		return float64(y), err
+	}, float64(minX), float64(maxX), res)
+	root = F64(r)
+	return
+}
+
+// factor returns multiplier of function value on the retained border
+// of interval for Illinois and Anderson-Bjorck methods, where y is
+// value of function in the new border and yOld is value of function
+// in the replaced border
+func factor(m Method, y, yOld float64) float64 {
+	if m == AndersonBjorck {
+		if f := 1.0 - y/yOld; 0 < f {
+			return f
+		}
+	}
+	return 0.5
+}
+
+// MinInterval returns minimal achievable width of interval near x
+// in float64 arithmetic. It is distance between x and next float64
+// value in direction of +Inf.
+//
+//	Input data:
+//		x - value of variable X
+//	Output data:
+//		width of interval
+//
+// Searching is stopped, when width of interval is not more that
+// value, because the interval cannot be splitted.
+func MinInterval(x float64) float64 {
+	return math.Nextafter(x, math.Inf(1)) - x
+}
+
+// xError returns relative width of interval as in the table of
+// documentation of MaxIteration. For zero border returns absolute width
+// of interval, same as stop criterion.
+func xError(xLeft, xRigth float64) float64 {
+	if xRigth == 0 {
+		return xRigth - xLeft
+	}
+	return (xRigth - xLeft) / xRigth
+}
+
+// trace writes state of iteration
+func trace(cfg Config, iter int, xLeft, xRoot, xRigth, yRoot float64) {
+	if cfg.TableFormat {
+		if iter == 0 {
+			fmt.Fprintf(cfg.Trace, "%-5s%-16s%-16s%s\n",
+				"It.", "X value", "Y value", "Xerror")
+		}
+		fmt.Fprintf(cfg.Trace, "%3d%16.6e%16.6e%16.6e\n",
+			iter, xRoot, yRoot, xError(xLeft, xRigth))
+		return
+	}
+	fmt.Fprintf(cfg.Trace, "%d: [%.3e, %.3e] x = %.3e y = %.3e\n",
+		iter, xLeft, xRigth, xRoot, yRoot)
+}
+
+// maxStagnation is max allowable amount of successive iterations,
+// when next point of searching is not moved more one ULP
+const maxStagnation = 4
+
+// solve is root-finding on float64 values without recovering
+func solve(cfg Config, f func(float64) (float64, error), minX, maxX float64, res *Result) (root float64, err error) {
+	if err = checkPrecision(cfg.Precision); err != nil {
+		return
+	}
+	for _, tol := range [...]float64{cfg.AbsTol, cfg.RelTol,
+		cfg.Tolerance.AbsX, cfg.Tolerance.RelX, cfg.Tolerance.AbsY} {
+		if !(0 <= tol) || math.IsInf(tol, 0) {
+			err = ErrorFind{
+				Type: NotValidValue,
+				Err:  fmt.Errorf("tolerance is not valid: %s", fmtFloat(tol)),
+			}
+			return
+		}
+	}
+	for _, x := range [...]float64{minX, maxX} {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			err = ErrorFind{
+				Type: NotValidValue,
+				Err:  fmt.Errorf("border is not valid: %s", fmtFloat(x)),
+			}
+			return
+		}
+	}
+	if minX == maxX {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("interval have zero width: %s", fmtFloat(minX)),
+		}
+		return
+	}
 	// replace borders
 	if minX > maxX {
+		if cfg.StrictBounds {
+			err = ErrorFind{
+				Type: NotValidValue,
+				Err:  fmt.Errorf("minX > maxX: %s > %s", fmtFloat(minX), fmtFloat(maxX)),
+			}
+			return
+		}
 		minX, maxX = maxX, minX
 	}
+	if cfg.guessed && !(minX <= cfg.guess && cfg.guess <= maxX) {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("guess is outside of interval: %s", fmtFloat(cfg.guess)),
+		}
+		return
+	}
 	// preparing variables
 	var (
-		xLeft, xRigth = minX, maxX
-		middle        = func() F64 {
-			return xLeft + (xRigth-xLeft)/2.0
-		}
-		xRoot            = middle()
-		yLeft, errLeft   = f(xLeft)
-		yRoot, errRoot   = f(xRoot)
-		yRigth, errRigth = f(xRigth)
+		xLeft, xRigth              = minX, maxX
+		xRoot                      = xLeft + (xRigth-xLeft)/2.0
+		yLeft, yRoot, yRigth       float64
+		errLeft, errRoot, errRigth error
+		xLast                      = xRigth // X value of the last function call
+		xBest, yBest               = math.NaN(), math.Inf(1)
+		stagnation                 int        // amount of iterations without moving
+		wLeft, wRigth              = 1.0, 1.0 // weights of borders for Illinois and Anderson-Bjorck
+		retained                   int        // side of last retained border
+		tol                        = cfg.Tolerance
+		residuals                  [3]float64 // residuals of the last iterations
+		weighted                   = cfg.Method == Illinois || cfg.Method == AndersonBjorck
 
-		prec    = Precision
-		maxIter = MaxIteration
+		prec     = cfg.Precision
+		maxIter  = cfg.MaxIteration
+		minSlope = cfg.MinSlope
 	)
-	// another algo
-	// just for information
-	//
-	// middle = func() float64 {
-	// 	// xLeft , yLeft  //
-	// 	// xRigth, yRigth //
-	// 	var (
-	// 		dY = yRigth - yLeft
-	// 		dX = xRigth - xLeft
-	// 	)
-	// 	if 1e-6 < math.Abs(dX) {
-	// 		a := dY / dX
-	// 		b := yRigth - a*xRigth
-	// 		x := -b / a
-	// 		if xLeft < x && x < xRigth {
-	// 			return x
-	// 		}
-	// 	}
-	// 	return xLeft + (xRigth-xLeft)/2.0
-	// }
+	if cfg.guessed && minX < cfg.guess && cfg.guess < maxX {
+		xRoot = cfg.guess
+	}
+	if res != nil {
+		res.EstimatedOrder = math.NaN()
+	}
+	if cfg.concurrent != nil {
+		ys, errs := evaluate(cfg.concurrent, [3]float64{xLeft, xRoot, xRigth})
+		yLeft, yRoot, yRigth = ys[0], ys[1], ys[2]
+		errLeft, errRoot, errRigth = errs[0], errs[1], errs[2]
+	} else {
+		yLeft, errLeft = f(xLeft)
+		yRoot, errRoot = f(xRoot)
+		yRigth, errRigth = f(xRigth)
+	}
+	// middle returns next point of searching
+	middle := func() float64 {
+		if cfg.Midpoint != nil {
+			if x := cfg.Midpoint(xLeft, yLeft, xRigth, yRigth); xLeft < x && x < xRigth {
+				return x
+			}
+			// bisection
+			return xLeft + (xRigth-xLeft)/2.0
+		}
+		if cfg.Method == FalsePosition || weighted {
+			var (
+				dY = wRigth*yRigth - wLeft*yLeft
+				dX = xRigth - xLeft
+			)
+			if minSlope < math.Abs(dY/dX) {
+				x := xLeft - wLeft*yLeft*dX/dY
+				if xLeft < x && x < xRigth {
+					return x
+				}
+			}
+		}
+		// bisection
+		return xLeft + (xRigth-xLeft)/2.0
+	}
+	// best stores X value with minimal absolute value of function
+	best := func(x, y float64) {
+		if y = math.Abs(y); y < yBest {
+			xBest, yBest = x, y
+		}
+	}
+	// final run function for the root
+	final := func(x float64) (float64, error) {
+		if cfg.ReturnBest {
+			x = xBest
+		}
+		switch cfg.FinalCall {
+		case Never:
+			return x, nil
+		case OnlyIfMoved:
+			if x == xLast {
+				return x, nil
+			}
+		}
+		_, err := f(x)
+		return x, err
+	}
+	// store final interval
+	store := func() {
+		if res != nil {
+			res.BestX, res.BestResidual = xBest, yBest
+			res.Lower, res.Upper = xLeft, xRigth
+			res.yLeft, res.yRigth = yLeft, yRigth
+		}
+	}
 	// check errors
-	for _, errLocal := range []error{errLeft, errRigth, errRoot} {
-		if errLocal != nil {
-			err = errLocal
-			return
+	if errLeft != nil {
+		err = ErrorFind{
+			Type: InternalErr,
+			Err:  fmt.Errorf("error evaluating f(minX): %w", errLeft),
 		}
+		return
+	}
+	if errRigth != nil {
+		err = ErrorFind{
+			Type: InternalErr,
+			Err:  fmt.Errorf("error evaluating f(maxX): %w", errRigth),
+		}
+		return
+	}
+	if errRoot != nil {
+		err = ErrorFind{
+			Type: InternalErr,
+			Err:  fmt.Errorf("error evaluating f(middle): %w", errRoot),
+		}
+		return
 	}
+	best(xLeft, yLeft)
+	best(xRoot, yRoot)
+	best(xRigth, yRigth)
 
-	if math.Abs(float64(yLeft)) < prec {
+	if math.Abs(yLeft) < prec && math.Abs(yRigth) < prec {
+		// both borders are roots
+		switch cfg.BothEndpointsRoots {
+		case ReturnRight:
+			store()
+			res.atEndpoint()
+			root, err = final(xRigth)
+			return
+		case ReturnError:
+			err = ErrorFind{
+				Type: InternalErr,
+				Err: fmt.Errorf("both borders are roots: [%s, %s]",
+					fmtFloat(xLeft), fmtFloat(xRigth)),
+			}
+			return
+		}
+	}
+	if math.Abs(yLeft) < prec {
 		// find the solution
-		root = xLeft
-		_, err = f(F64(root))
+		store()
+		res.atEndpoint()
+		root, err = final(xLeft)
 		return
 	}
-	if math.Abs(float64(yRigth)) < prec {
+	if math.Abs(yRigth) < prec {
 		// find the solution
-		root = xRigth
-		_, err = f(F64(root))
+		store()
+		res.atEndpoint()
+		root, err = final(xRigth)
 		return
 	}
 
+	// scale of function values
+	scale := 1.0
+	if cfg.ScaleByEndpoints {
+		scale = math.Sqrt(math.Abs(yLeft) * math.Abs(yRigth))
+	}
+
+	if math.Abs(yLeft-yRoot) < prec && math.Abs(yRoot-yRigth) < prec {
+		err = ErrorFind{
+			Type: InternalErr,
+			Err: fmt.Errorf("%w: [%s, %s, %s]",
+				ErrConstant, fmtFloat(yLeft), fmtFloat(yRoot), fmtFloat(yRigth)),
+		}
+		return
+	}
+
+	if cfg.PreScan != 0 {
+		var ys []float64
+		if _, ys, err = Sample(f, xLeft, xRigth, cfg.PreScan); err != nil {
+			return
+		}
+		var changes int
+		for i := 1; i < len(ys); i++ {
+			if math.Signbit(ys[i]) != math.Signbit(ys[i-1]) {
+				changes++
+			}
+		}
+		if 1 < changes {
+			err = ErrorFind{
+				Type: MultipleRoots,
+				Err: fmt.Errorf("%d sign changes on %d samples",
+					changes, cfg.PreScan),
+			}
+			return
+		}
+	}
+
+	if cfg.ExpectedDirection != Any && math.Signbit(yLeft) != math.Signbit(yRigth) {
+		direction := NegToPos
+		if yRigth < 0 {
+			direction = PosToNeg
+		}
+		if direction != cfg.ExpectedDirection {
+			err = ErrorFind{
+				Type: NotValidValue,
+				Err: fmt.Errorf("sign change is %s, but expected %s: [%s, %s]",
+					direction, cfg.ExpectedDirection, fmtFloat(yLeft), fmtFloat(yRigth)),
+			}
+			return
+		}
+	}
+
+	// custom is true, when stopping criteria is defined by configuration,
+	// so linear interpolation at the start of searching is not used
+	custom := cfg.Converged != nil || tol != (Tolerance{}) ||
+		cfg.ToleranceFunc != nil || cfg.AbsTol != 0 || cfg.RelTol != 0 ||
+		cfg.TerminateOnWidthOnly || cfg.AbortOnResidualIncrease
+
+	if !cfg.bisectOnly && !custom &&
+		math.Signbit(yLeft) != math.Signbit(yRigth) &&
+		math.Abs(yRoot-(yLeft+yRigth)/2.0) < prec {
+		// function is linear, so the root is found by interpolation
+		x := xLeft - yLeft*(xRigth-xLeft)/(yRigth-yLeft)
+		if xLeft < x && x < xRigth {
+			var y float64
+			if y, err = f(x); err != nil {
+				err = ErrorFind{
+					Type: InternalErr,
+					Err:  err,
+				}
+				return
+			}
+			best(x, y)
+			xLast = x
+			xPrevLeft, xPrevRigth := xLeft, xRigth
+			if math.Signbit(y) == math.Signbit(yLeft) && y != 0 {
+				xLeft, yLeft = x, y
+			} else {
+				xRigth, yRigth = x, y
+			}
+			if math.Abs(y) < prec*scale {
+				// find the solution by one step
+				if cfg.Trace != nil {
+					trace(cfg, 0, xPrevLeft, x, xPrevRigth, y)
+				}
+				if cfg.OnStep != nil {
+					cfg.OnStep(0, x, y, xError(xPrevLeft, xPrevRigth))
+				}
+				store()
+				root, err = final(x)
+				return
+			}
+			// previous middle point may be outside of new interval
+			xRoot = xLeft + (xRigth-xLeft)/2.0
+			if yRoot, err = f(xRoot); err != nil {
+				err = ErrorFind{
+					Type: InternalErr,
+					Err:  err,
+				}
+				return
+			}
+			if err = checkValues(xRoot, yRoot); err != nil {
+				return
+			}
+			best(xRoot, yRoot)
+			xLast = xRoot
+		}
+	}
+
 	// iterations
 	for iter := 0; ; iter++ {
+		if res != nil {
+			res.Iterations = iter
+			if yRoot != 0 {
+				residuals = [3]float64{residuals[1], residuals[2], math.Abs(yRoot)}
+			}
+			if 3 <= iter && residuals[0] != 0 {
+				res.EstimatedOrder = estimateOrder(residuals[0], residuals[1], residuals[2])
+				if math.IsInf(res.EstimatedOrder, 0) {
+					res.EstimatedOrder = math.NaN()
+				}
+			}
+		}
 		// check max iteration
 		if iter >= maxIter {
+			if math.Abs(yRoot) < prec*scale {
+				// absolute value of function is acceptable
+				break
+			}
 			err = ErrorFind{
 				Type: MaximalIteration,
 				Err:  fmt.Errorf("Too many iterations: %d", iter),
 			}
 			return
 		}
-		if xLeft == 0 {
-			if math.Abs(float64(yRoot)) < prec && math.Abs(float64(xRigth-xLeft)) < prec {
-				break // find the solution
+		if cfg.Trace != nil {
+			trace(cfg, iter, xLeft, xRoot, xRigth, yRoot)
+		}
+		if cfg.OnStep != nil {
+			cfg.OnStep(iter, xRoot, yRoot, xError(xLeft, xRigth))
+		}
+		var (
+			residual = math.Abs(yRoot) < prec*scale || cfg.TerminateOnWidthOnly
+			width    bool
+		)
+		if cfg.Converged != nil {
+			residual = true
+			width = cfg.Converged(iter, xRoot, yRoot, xRigth-xLeft)
+		} else if tol != (Tolerance{}) {
+			residual = tol.AbsY == 0 || math.Abs(yRoot) < tol.AbsY
+			width = (tol.AbsX == 0 && tol.RelX == 0) ||
+				math.Abs(xRigth-xLeft) <= tol.AbsX+tol.RelX*math.Abs(xRoot)
+		} else if cfg.ToleranceFunc != nil {
+			tol := cfg.ToleranceFunc(xRoot)
+			if !(0 < tol) || math.IsInf(tol, 0) {
+				err = ErrorFind{
+					Type: NotValidValue,
+					Err: fmt.Errorf("tolerance is not valid: %s at %s",
+						fmtFloat(tol), fmtFloat(xRoot)),
+				}
+				return
 			}
+			width = math.Abs(xRigth-xLeft) < tol
+		} else if cfg.AbsTol != 0 || cfg.RelTol != 0 {
+			width = math.Abs(xRigth-xLeft) <= cfg.AbsTol+cfg.RelTol*math.Abs(xRoot)
+		} else if xLeft == 0 {
+			width = math.Abs(xRigth-xLeft) < prec
 		} else {
-			if math.Abs(float64(yRoot)) < prec && math.Abs(float64((xRigth-xLeft)/xLeft)) < prec {
-				break // find the solution
+			width = math.Abs((xRigth-xLeft)/xLeft) < prec
+		}
+		if residual && width {
+			break // find the solution
+		}
+		if xRigth-xLeft <= math.Max(MinInterval(xLeft), MinInterval(xRigth)) {
+			// interval cannot be splitted in float64 arithmetic
+			if !residual {
+				// value of function is not small, so sign of function
+				// is changed by jump or precision is not achievable
+				store()
+				root = xRoot
+				err = ErrorFind{
+					Type: Discontinuity,
+					Err: fmt.Errorf("sign change without root: |f(%s)| = %s",
+						fmtFloat(xRoot), fmtFloat(math.Abs(yRoot))),
+				}
+				return
 			}
+			break
 		}
-		if math.Signbit(float64(yLeft)) != math.Signbit(float64(yRoot)) {
-			xRigth, yRigth = xRoot, yRigth
-		} else if math.Signbit(float64(yRoot)) != math.Signbit(float64(yRigth)) {
+		xPrevLeft, xPrevRigth := xLeft, xRigth
+		// zero value of function is related to the right part of
+		// interval for finding the leftmost root of flat zero region.
+		// Positive and negative zero are same, because comparison
+		// with zero is before checking of sign bit.
+		if yRoot == 0 || math.Signbit(yLeft) != math.Signbit(yRoot) {
+			if weighted {
+				if retained < 0 {
+					wLeft *= factor(cfg.Method, yRoot, yRigth)
+				}
+				wRigth = 1.0
+				retained = -1
+			}
+			xRigth, yRigth = xRoot, yRoot
+		} else if yRigth == 0 || math.Signbit(yRoot) != math.Signbit(yRigth) {
+			if weighted {
+				if 0 < retained {
+					wRigth *= factor(cfg.Method, yRoot, yLeft)
+				}
+				wLeft = 1.0
+				retained = 1
+			}
 			xLeft, yLeft = xRoot, yRoot
 		} else {
 			err = ErrorFind{
 				Type: InternalErr,
-				Err: fmt.Errorf("No root: [%.3e, %.3e, %.3e]",
-					yLeft, yRoot, yRigth),
+				Err: NoBracketError{
+					XLeft: xLeft, XRight: xRigth,
+					YLeft: yLeft, YRight: yRigth,
+				},
+			}
+			return
+		}
+		if cfg.SafeMode && !(xPrevLeft <= xLeft && xRigth <= xPrevRigth &&
+			xRigth-xLeft < xPrevRigth-xPrevLeft) {
+			err = ErrorFind{
+				Type: InternalErr,
+				Err: fmt.Errorf("bracket invariant violated: [%s, %s] is not in [%s, %s]",
+					fmtFloat(xLeft), fmtFloat(xRigth), fmtFloat(xPrevLeft), fmtFloat(xPrevRigth)),
 			}
 			return
 		}
 		// preparing next middle point
 		xRoot = middle()
+		if 0 < cfg.lipschitz && cfg.lipschitz*(xRigth-xLeft) < prec {
+			// absolute value of function in any point of interval
+			// is less precision
+			break
+		}
+		if math.Abs(xRoot-xLast) <= MinInterval(xLast) &&
+			sameULPs*math.Max(MinInterval(xLeft), MinInterval(xRigth)) < xRigth-xLeft {
+			// interval is wide, but point is not moved
+			stagnation++
+		} else {
+			stagnation = 0
+		}
+		if maxStagnation <= stagnation {
+			// best estimation is returned together with error
+			store()
+			root = xBest
+			err = ErrorFind{
+				Type: Stalled,
+				Err: fmt.Errorf("search stagnated: [%s, %s, %s]",
+					fmtFloat(xLeft), fmtFloat(xRoot), fmtFloat(xRigth)),
+			}
+			return
+		}
+		xLast = xRoot
+		yPrev := yRoot
 		if yRoot, errRoot = f(xRoot); errRoot != nil {
 			err = ErrorFind{
 				Type: InternalErr,
@@ -206,36 +1410,48 @@ func Find[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), minX, maxX F64
 			}
 			return
 		}
-		if math.IsNaN(float64(xRoot)) {
+		best(xRoot, yRoot)
+		if cfg.iteration != nil {
+			cfg.iteration(xLeft, xRoot, xRigth, yRoot)
+		}
+		if math.IsNaN(xRoot) {
 			err = ErrorFind{
 				Type: NotValidValue,
 				Err:  fmt.Errorf("xRoot is NaN"),
 			}
 			return
 		}
-		if math.IsNaN(float64(yRoot)) {
+		if math.IsNaN(yRoot) {
 			err = ErrorFind{
 				Type: NotValidValue,
 				Err:  fmt.Errorf("yRoot is NaN"),
 			}
 			return
 		}
-		if math.IsInf(float64(xRoot), 0) {
+		if math.IsInf(xRoot, 0) {
 			err = ErrorFind{
 				Type: NotValidValue,
 				Err:  fmt.Errorf("xRoot is Inf"),
 			}
 			return
 		}
-		if math.IsInf(float64(yRoot), 0) {
+		if math.IsInf(yRoot, 0) {
 			err = ErrorFind{
 				Type: NotValidValue,
 				Err:  fmt.Errorf("yRoot is Inf"),
 			}
 			return
 		}
+		if cfg.AbortOnResidualIncrease && math.Abs(yPrev) < math.Abs(yRoot) {
+			err = ErrorFind{
+				Type: InternalErr,
+				Err: fmt.Errorf("residual increased: %s < %s",
+					fmtFloat(math.Abs(yPrev)), fmtFloat(math.Abs(yRoot))),
+			}
+			return
+		}
 	}
-	root = xRoot
-	_, err = f(F64(root))
+	store()
+	root, err = final(xRoot)
 	return
 }
@@ -1,9 +1,14 @@
 package root_test
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"os"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/Konstantin8105/root"
 )
@@ -13,230 +18,20 @@ type tc struct {
 	Xmin, Xmax float64
 }
 
-var tcs = []tc{
-	{
-		func(x float64) float64 {
-			return (3.8-3.0*math.Sin(math.Sqrt(x)))/0.35 - x
-		},
-		2.0,
-		3.0,
-	},
-	{
-		func(x float64) float64 {
-			return 1.0/(3.0+math.Sin(3.6*x)) - x
-		},
-		0.0,
-		0.85,
-	},
-	{
-		func(x float64) float64 {
-			return math.Cos(math.Sqrt(1.0-0.3*x*x*x)) - x
-		},
-		0,
-		1,
-	},
-	{
-		func(x float64) float64 {
-			return math.Sin(math.Sqrt(1-0.4*x*x)) - x
-		},
-		0,
-		1,
-	},
-	{
-		func(x float64) float64 {
-			return 0.25*x*x*x - x - 1.2502
-		},
-		2,
-		3,
-	},
-	{
-		func(x float64) float64 {
-			return 0.1*x*x - x*math.Log(x)
-		},
-		1,
-		2,
-	},
-	{
-		func(x float64) float64 {
-			return 3*x - 4*math.Log(x) - 5
-		},
-		2,
-		4,
-	},
-	{
-		func(x float64) float64 {
-			return math.Exp(x) - math.Exp(-x) - 2
-		},
-		0,
-		1,
-	},
-	{
-		func(x float64) float64 {
-			return x + math.Sqrt(x) + math.Pow(x, 1.0/3.0) - 2.5
-		},
-		0.4,
-		1,
-	},
-	{
-		func(x float64) float64 {
-			return math.Tan(x) - math.Pow(math.Tan(x), 3.0)/3 + math.Pow(math.Tan(x), 5)/5.0 - 1./3.
-		},
-		0,
-		0.8,
-	},
-	{
-		func(x float64) float64 {
-			return math.Cos(2.0/x) - 2.0*math.Sin(1./x) + 1./x
-		},
-		1,
-		2,
-	},
-	{
-		func(x float64) float64 {
-			return math.Sin(math.Log(x)) - math.Cos(math.Log(x)) + 2.0*math.Log(x)
-		},
-		1,
-		3,
-	},
-	{
-		func(x float64) float64 {
-			return math.Log(x) - x + 1.8
-		},
-		2,
-		3,
-	},
-	{
-		func(x float64) float64 {
-			return 0.4 + math.Atan(math.Sqrt(x)) - x
-		},
-		1,
-		2,
-	},
-	{
-		func(x float64) float64 {
-			return x*math.Tan(x) - 1/3.0
-		},
-		0.2,
-		1,
-	},
-	{
-		func(x float64) float64 {
-			return math.Tan(0.55*x+0.1) - x*x
-		},
-		0,
-		1,
-	},
-	{
-		func(x float64) float64 {
-			return 2.0 - math.Sin(1./x) - x
-		},
-		1.2,
-		2,
-	},
-	{
-		func(x float64) float64 {
-			return 1.0 + math.Sin(x) - math.Log(1+x) - x
-		},
-		0,
-		1.5,
-	},
-	{
-		func(x float64) float64 {
-			return math.Cos(math.Pow(x, 0.52)+2) + x
-		},
-		0.4,
-		1,
-	},
-	{
-		func(x float64) float64 {
-			return math.Sqrt(math.Log(1+x)+3) - x
-		},
-		2,
-		3,
-	},
-	{
-		func(x float64) float64 {
-			return math.Exp(x) + math.Log(x) - 10*x
-		},
-		3,
-		4,
-	},
-	{
-		func(x float64) float64 {
-			return 3*x - 14 + math.Exp(x) - math.Exp(-x)
-		},
-		1,
-		3,
-	},
-	{
-		func(x float64) float64 {
-			return 2*math.Pow(math.Log(x), 2) + 6*math.Log(x) - 5
-		},
-		1,
-		3,
-	},
-	{
-		func(x float64) float64 {
-			return 2*x*math.Sin(x) - math.Cos(x)
-		},
-		0.4,
-		1,
-	},
-	{
-		// some strange function
-		func(x float64) float64 {
-			return PartLine(x, []xys{
-				{0, 4}, {0.3, 1}, {1.3, 0.5}, {1.35, -0.5}, {2.0, -1.5},
-			})
-		},
-		0,
-		2,
-	},
-	{
-		// some strange function
-		func(x float64) float64 {
-			return PartLine(x, []xys{
-				{0, 3}, {0.25, 4}, {0.5, 0.1}, {2.0, -0.1},
-			})
-		},
-		0,
-		2,
-	},
-	{
-		// some strange function
-		func(x float64) float64 {
-			return PartLine(x, []xys{
-				{0, 3}, {0.1, 4}, {0.2, 0.01}, {1.6, -0.01}, {1.9, -4.0}, {2.0, -3.0},
-			})
-		},
-		0,
-		2,
-	},
-	{
-		// some strange function
-		func(x float64) float64 {
-			return PartLine(x, []xys{
-				{0, 3}, {0.1, 0.001}, {1.8, -0.001}, {2.0, -0.1},
-			})
-		},
-		0,
-		2,
-	},
-	{
-		func(x float64) float64 {
-			return x
-		},
-		0,
-		10,
-	},
-	{
-		func(x float64) float64 {
-			return 10 - x
-		},
-		0,
-		10,
-	},
-}
+var tcs = func() (tcs []tc) {
+	for _, tf := range root.TestFunctions() {
+		f := tf.F
+		tcs = append(tcs, tc{
+			f: func(x float64) float64 {
+				y, _ := f(x)
+				return y
+			},
+			Xmin: tf.Min,
+			Xmax: tf.Max,
+		})
+	}
+	return
+}()
 
 // cpu: Intel(R) Xeon(R) CPU           X5550  @ 2.67GHz
 // Benchmark/Case__0-16         	  782059	      1592 ns/op	       0 B/op	       0 allocs/op
@@ -284,6 +79,67 @@ func Benchmark(b *testing.B) {
 	}
 }
 
+// BenchmarkCases is sum of all cases in one operation, that is used for
+// comparing trees before and after splitting of Find on generic safe
+// wrapper and float64 core (best of 10, -cpu 1, -benchtime 300ms):
+//
+//	before split: BenchmarkCases 18641 ns/op 0 B/op 0 allocs/op
+//	after split:  BenchmarkCases 18373 ns/op 0 B/op 0 allocs/op
+func BenchmarkCases(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		for i := range tcs {
+			_, err := root.Find(func(x float64) (float64, error) {
+				return tcs[i].f(x), nil
+			}, tcs[i].Xmin, tcs[i].Xmax)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+func BenchmarkEvaluations(b *testing.B) {
+	var calls, iterations int
+	for n := 0; n < b.N; n++ {
+		for i := range tcs {
+			res, err := root.FindResult(func(x float64) (float64, error) {
+				calls++
+				return tcs[i].f(x), nil
+			}, tcs[i].Xmin, tcs[i].Xmax)
+			if err != nil {
+				panic(err)
+			}
+			iterations += res.Iterations
+		}
+	}
+	b.ReportMetric(float64(calls)/float64(b.N*len(tcs)), "calls/op")
+	b.ReportMetric(float64(iterations)/float64(b.N*len(tcs)), "iterations/op")
+}
+
+func BenchmarkType(b *testing.B) {
+	type F64 float64
+	b.Run("float64", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			_, err := root.Find(func(x float64) (float64, error) {
+				return tcs[0].f(x), nil
+			}, tcs[0].Xmin, tcs[0].Xmax)
+			if err != nil {
+				panic(err)
+			}
+		}
+	})
+	b.Run("F64", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			_, err := root.Find(func(x F64) (F64, error) {
+				return F64(tcs[0].f(float64(x))), nil
+			}, F64(tcs[0].Xmin), F64(tcs[0].Xmax))
+			if err != nil {
+				panic(err)
+			}
+		}
+	})
+}
+
 func Test(t *testing.T) {
 	var counter int64
 	for i := range tcs {
@@ -339,32 +195,6 @@ func Test(t *testing.T) {
 	t.Logf("Average amount of calls: %.2f", averageCalls)
 }
 
-type xys struct {
-	x, y float64
-}
-
-func PartLine(x float64, xy []xys) float64 {
-	last := len(xy) - 1
-	if x < xy[0].x {
-		return line(x, xy[0].x, xy[0].y, xy[1].x, xy[1].y)
-	}
-	if x > xy[last].x {
-		return line(x, xy[last-1].x, xy[last-1].y, xy[last].x, xy[last].y)
-	}
-	for i := 1; i < len(xy); i++ {
-		if xy[i-1].x <= x && x <= xy[i].x {
-			return line(x, xy[i-1].x, xy[i-1].y, xy[i].x, xy[i].y)
-		}
-	}
-	return -42.0
-}
-
-func line(x, x0, y0, x1, y1 float64) float64 {
-	a := (y1 - y0) / (x1 - x0)
-	b := y0 - a*x0
-	return a*x + b
-}
-
 func TestPanic(t *testing.T) {
 	p := func(float64) (float64, error) {
 		panic("PANIC")
@@ -387,6 +217,48 @@ func TestChangeMinMax(t *testing.T) {
 	}
 }
 
+func TestStrictBounds(t *testing.T) {
+	nr := func(x float64) (float64, error) {
+		return 2*x + 1, nil
+	}
+	_, err := root.FindWith(root.Config{StrictBounds: true}, nr, 10, -10)
+	t.Logf("%v", err)
+	var ef root.ErrorFind
+	if !errors.As(err, &ef) || ef.Type != root.NotValidValue {
+		t.Fatalf("reversed borders are accepted: %v", err)
+	}
+	if _, err = root.FindWith(root.Config{StrictBounds: true}, nr, -10, 10); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	defer func(prec float64) {
+		root.Precision = prec
+	}(root.Precision)
+	f := func(x float64) (float64, error) {
+		return 2*x - 1, nil
+	}
+	for _, prec := range []float64{0, -1e-6, math.NaN(), math.Inf(1)} {
+		t.Run(fmt.Sprintf("%e", prec), func(t *testing.T) {
+			root.Precision = prec
+			_, err := root.Find(f, 0, 10)
+			t.Logf("%v", err)
+			var ef root.ErrorFind
+			if !errors.As(err, &ef) || ef.Type != root.NotValidValue {
+				t.Fatalf("not valid precision is accepted: %v", err)
+			}
+		})
+	}
+	t.Run("config", func(t *testing.T) {
+		_, err := root.FindWith(root.Config{Precision: -1}, f, 0, 10)
+		t.Logf("%v", err)
+		if err == nil {
+			t.Fatalf("not valid precision is accepted")
+		}
+	})
+}
+
 func TestNoRoot(t *testing.T) {
 	nr := func(x float64) (float64, error) {
 		return 2*x + 5, nil
@@ -398,6 +270,22 @@ func TestNoRoot(t *testing.T) {
 	}
 }
 
+func TestRightBorder(t *testing.T) {
+	// function value on the right border is updated, so the root is
+	// found, when function have same sign on borders
+	nr := func(x float64) (float64, error) {
+		return (x - 0.3) * (x - 0.7), nil
+	}
+	r, err := root.Find(nr, 0, 1)
+	t.Logf("%v", err)
+	if err != nil {
+		t.Fatalf("Finding not valid root")
+	}
+	if 1e-5 < math.Abs(r-0.3) {
+		t.Errorf("not valid root: %e", r)
+	}
+}
+
 func TestRootError(t *testing.T) {
 	r, err := root.Find(func(x float64) (y float64, err error) {
 		if -1 <= x && x <= 1 {
@@ -455,3 +343,1565 @@ func TestNoSomeRoot(t *testing.T) {
 		}
 	}
 }
+
+func TestTestFunctions(t *testing.T) {
+	fs := root.TestFunctions()
+	if len(fs) != len(tcs) {
+		t.Fatalf("not valid amount of functions: %d", len(fs))
+	}
+	for i, tf := range fs {
+		t.Run(fmt.Sprintf("Case%3d", i), func(t *testing.T) {
+			rootX, err := root.Find(tf.F, tf.Min, tf.Max)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rootX < tf.Min || tf.Max < rootX {
+				t.Errorf("not valid root")
+			}
+			y, err := tf.F(rootX)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if root.Precision < math.Abs(y) {
+				t.Errorf("not valid precision: %e", y)
+			}
+		})
+	}
+}
+
+func TestFindResult(t *testing.T) {
+	for i := range tcs {
+		t.Run(fmt.Sprintf("Case%3d", i), func(t *testing.T) {
+			var counter int
+			tempFunc := func(x float64) (float64, error) {
+				counter++
+				return tcs[i].f(x), nil
+			}
+			res, err := root.FindResult(tempFunc, tcs[i].Xmin, tcs[i].Xmax)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Evaluations != counter {
+				t.Errorf("not valid amount of evaluations: %d != %d",
+					res.Evaluations, counter)
+			}
+			// only last operation of finding is duplicate
+			if 1 < res.DuplicateEvaluations {
+				t.Errorf("too many duplicate evaluations: %d",
+					res.DuplicateEvaluations)
+			}
+			if res.Iterations < 0 || counter < res.Iterations {
+				t.Errorf("not valid amount of iterations: %d", res.Iterations)
+			}
+			// function is called once per iteration, because values
+			// on borders of interval are kept
+			if res.Iterations+4 < res.Evaluations {
+				t.Errorf("too many evaluations: %d for %d iterations",
+					res.Evaluations, res.Iterations)
+			}
+			if !(res.Lower <= res.Root && res.Root <= res.Upper) {
+				t.Errorf("root %e is outside of [%e, %e]", res.Root, res.Lower, res.Upper)
+			}
+			if yl, yu := tcs[i].f(res.Lower), tcs[i].f(res.Upper); 0 < yl*yu &&
+				root.Precision <= math.Min(math.Abs(yl), math.Abs(yu)) {
+				t.Errorf("root is not bracketed: [%e, %e]", res.Lower, res.Upper)
+			}
+			if y := math.Abs(tcs[i].f(res.Root)); res.FinalResidual != y {
+				t.Errorf("not valid final residual: %e != %e", res.FinalResidual, y)
+			}
+			rootX, err := root.Find(tempFunc, tcs[i].Xmin, tcs[i].Xmax)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rootX != res.Root {
+				t.Errorf("not same root: %e != %e", rootX, res.Root)
+			}
+		})
+	}
+}
+
+func TestDuplicateEvaluations(t *testing.T) {
+	var counter int
+	f := func(x float64) (float64, error) {
+		counter++
+		return x*x - 0.25, nil
+	}
+	// each next point is next float64 after left border, so all
+	// function calls after calls on initial points are duplicate
+	res, err := root.FindResultWith(root.Config{
+		Midpoint: func(xLeft, yLeft, xRigth, yRigth float64) float64 {
+			return math.Nextafter(xLeft, xRigth)
+		},
+	}, f, 0.25, 1.0)
+	t.Logf("%v", err)
+	if res.Evaluations != counter {
+		t.Errorf("not valid amount of evaluations: %d != %d",
+			res.Evaluations, counter)
+	}
+	if res.Evaluations <= 3 {
+		t.Fatalf("not enough evaluations: %d", res.Evaluations)
+	}
+	if res.DuplicateEvaluations != res.Evaluations-3 {
+		t.Errorf("not valid amount of duplicate evaluations: %d != %d",
+			res.DuplicateEvaluations, res.Evaluations-3)
+	}
+}
+
+func TestAtEndpoint(t *testing.T) {
+	for _, i := range []int{28, 29} {
+		tf := root.TestFunctions()[i]
+		res, err := root.FindResult(tf.F, tf.Min, tf.Max)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.AtEndpoint {
+			t.Errorf("Case %d: root %e is not at endpoint", i, res.Root)
+		}
+	}
+	res, err := root.FindResult(root.TestFunctions()[26].F, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AtEndpoint {
+		t.Errorf("interior root %e is at endpoint", res.Root)
+	}
+}
+
+func TestFindWith(t *testing.T) {
+	for _, method := range []root.Method{root.Bisection, root.FalsePosition, root.Illinois, root.AndersonBjorck} {
+		t.Run(method.String(), func(t *testing.T) {
+			for i := range tcs {
+				if method == root.FalsePosition && (i == 26 || i == 27) {
+					// flat parts of function
+					continue
+				}
+				rootX, err := root.FindWith(root.Config{Method: method},
+					func(x float64) (float64, error) {
+						return tcs[i].f(x), nil
+					}, tcs[i].Xmin, tcs[i].Xmax)
+				if err != nil {
+					t.Errorf("Case %d: %v", i, err)
+					continue
+				}
+				if root.Precision < math.Abs(tcs[i].f(rootX)) {
+					t.Errorf("Case %d: not valid precision: %e", i, math.Abs(tcs[i].f(rootX)))
+				}
+			}
+		})
+	}
+}
+
+func TestNotValidBorders(t *testing.T) {
+	var calls int
+	f := func(x float64) (float64, error) {
+		calls++
+		return x - 1, nil
+	}
+	for _, b := range [][2]float64{
+		{math.Inf(1), 0},
+		{0, math.Inf(-1)},
+		{math.NaN(), 2},
+		{0, math.NaN()},
+		{1, 1},
+	} {
+		_, err := root.Find(f, b[0], b[1])
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.NotValidValue {
+			t.Errorf("%v: not valid error: %v", b, err)
+		}
+	}
+	if calls != 0 {
+		t.Errorf("function is called for not valid borders: %d", calls)
+	}
+}
+
+func TestNoBracketError(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return x*x + 1, nil
+	}
+	_, err := root.Find(f, -1, 2)
+	t.Logf("%v", err)
+	var nb root.NoBracketError
+	if !errors.As(err, &nb) {
+		t.Fatalf("not valid error: %v", err)
+	}
+	if nb.XLeft != -1 || nb.XRight != 2 || nb.YLeft != 2 || nb.YRight != 5 {
+		t.Errorf("not valid values: %#v", nb)
+	}
+	var ef root.ErrorFind
+	if !errors.As(err, &ef) || ef.Type != root.InternalErr {
+		t.Errorf("not valid type of error: %v", err)
+	}
+	if !errors.Is(err, root.ErrNoSignChange) {
+		t.Errorf("error is not unwrapped: %v", err)
+	}
+}
+
+func TestEstimatedOrder(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return math.Exp(x) - 2, nil
+	}
+	for _, m := range []root.Method{root.Bisection, root.FalsePosition, root.Illinois} {
+		res, err := root.FindResultWith(root.Config{Method: m}, f, 0, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("%s: iterations %d, order %.3f", m, res.Iterations, res.EstimatedOrder)
+		if math.IsNaN(res.EstimatedOrder) {
+			t.Errorf("%s: order is not estimated", m)
+		}
+	}
+	t.Run("linear", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return 2*x - 3, nil
+		}
+		res, err := root.FindResult(g, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !math.IsNaN(res.EstimatedOrder) {
+			t.Errorf("order is estimated without iterations: %e", res.EstimatedOrder)
+		}
+	})
+}
+
+func TestPreScan(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return (x - 0.2) * (x - 0.5) * (x - 0.8), nil
+	}
+	if _, err := root.Find(f, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	_, err := root.FindWith(root.Config{PreScan: 20}, f, 0, 1)
+	t.Logf("%v", err)
+	var ef root.ErrorFind
+	if !errors.As(err, &ef) || ef.Type != root.MultipleRoots {
+		t.Fatalf("not valid error: %v", err)
+	}
+	t.Run("single", func(t *testing.T) {
+		rootX, err := root.FindWith(root.Config{PreScan: 20}, f, 0.6, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-5 < math.Abs(rootX-0.8) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("not valid", func(t *testing.T) {
+		_, err := root.FindWith(root.Config{PreScan: 1}, f, 0, 1)
+		if !errors.As(err, &ef) || ef.Type != root.NotValidValue {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
+
+func TestMidpoint(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return (x - 0.3) * (1 + x), nil
+	}
+	secant := func(xLeft, yLeft, xRigth, yRigth float64) float64 {
+		return xLeft - yLeft*(xRigth-xLeft)/(yRigth-yLeft)
+	}
+	res, err := root.FindResult(f, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var calls int
+	g := func(x float64) (float64, error) {
+		calls++
+		return f(x)
+	}
+	rootX, err := root.FindWith(root.Config{Midpoint: secant}, g, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("evaluations: bisection %d, secant %d", res.Evaluations, calls)
+	if res.Evaluations <= calls {
+		t.Errorf("secant midpoint is not faster")
+	}
+	if y, _ := f(rootX); root.Precision < math.Abs(y) {
+		t.Errorf("not valid root: %e", rootX)
+	}
+	t.Run("outside", func(t *testing.T) {
+		// point outside of interval is replaced by bisection
+		outside := func(_, _, xRigth, _ float64) float64 {
+			return xRigth + 1
+		}
+		rootX, err := root.FindWith(root.Config{Midpoint: outside}, f, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect, err := root.Find(f, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rootX != expect {
+			t.Errorf("not same root: %e != %e", rootX, expect)
+		}
+	})
+}
+
+func TestMinSlope(t *testing.T) {
+	// slope of function collapses
+	f := func(x float64) (float64, error) {
+		return 1e-7 * (x - 300), nil
+	}
+	for _, method := range []root.Method{root.Bisection, root.FalsePosition} {
+		t.Run(method.String(), func(t *testing.T) {
+			rootX, err := root.FindWith(root.Config{Method: method}, f, 0, 1000)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.IsNaN(rootX) || math.IsInf(rootX, 0) {
+				t.Fatalf("not valid root: %e", rootX)
+			}
+			if 1e-3 < math.Abs(rootX-300) {
+				t.Errorf("not valid root: %e", rootX)
+			}
+		})
+	}
+	t.Run("bisection fallback", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return x*x - 0.5, nil
+		}
+		expect, err := root.FindWith(root.Config{Method: root.Bisection}, g, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual, err := root.FindWith(root.Config{
+			Method:   root.FalsePosition,
+			MinSlope: 1e6,
+		}, g, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expect != actual {
+			t.Errorf("bisection is not used: %e != %e", expect, actual)
+		}
+	})
+}
+
+func TestFindWithSlope(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return math.Exp(x) - 2, nil
+	}
+	rootX, slope, err := root.FindWithSlope(f, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// derivative of function in root
+	expect := math.Exp(rootX)
+	if 1e-5 < math.Abs(slope-expect) {
+		t.Errorf("not valid slope: %e != %e", slope, expect)
+	}
+}
+
+func TestFinalCall(t *testing.T) {
+	calls := func(fc root.FinalCall, i int) (counter int) {
+		_, err := root.FindWith(root.Config{FinalCall: fc},
+			func(x float64) (float64, error) {
+				counter++
+				return tcs[i].f(x), nil
+			}, tcs[i].Xmin, tcs[i].Xmax)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	for _, i := range []int{0, 28, 29} {
+		t.Run(fmt.Sprintf("Case%3d", i), func(t *testing.T) {
+			var (
+				always = calls(root.Always, i)
+				never  = calls(root.Never, i)
+				moved  = calls(root.OnlyIfMoved, i)
+			)
+			t.Logf("always = %d, never = %d, only if moved = %d", always, never, moved)
+			if never != always-1 {
+				t.Errorf("final call is not skipped")
+			}
+			expect := always - 1
+			if i == 28 {
+				// root on the left border, but the last call is
+				// on the right border
+				expect = always
+			}
+			if moved != expect {
+				t.Errorf("not valid amount of calls: %d != %d", moved, expect)
+			}
+		})
+	}
+}
+
+func TestFindLipschitz(t *testing.T) {
+	var counter int
+	f := func(x float64) (float64, error) {
+		counter++
+		return 0.01*(x-5.2) + 1e-4*math.Pow(x-5.2, 3), nil
+	}
+	counter = 0
+	expect, err := root.Find(f, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain := counter
+
+	counter = 0
+	actual, err := root.FindLipschitz(f, 0, 10, 0.02)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lipschitz := counter
+
+	t.Logf("amount of calls: plain = %d, lipschitz = %d", plain, lipschitz)
+	if plain <= lipschitz {
+		t.Errorf("amount of calls is not less")
+	}
+	if y, _ := f(actual); root.Precision < math.Abs(y) {
+		t.Errorf("not valid precision: %e", y)
+	}
+	if 1e-3 < math.Abs(expect-actual) {
+		t.Errorf("roots are not same: %e != %e", expect, actual)
+	}
+	for _, L := range []float64{0, -1, math.NaN()} {
+		if _, err := root.FindLipschitz(f, 0, 10, L); err == nil {
+			t.Errorf("not valid Lipschitz constant is accepted: %e", L)
+		}
+	}
+}
+
+func TestFindWithGuess(t *testing.T) {
+	var xs []float64
+	f := func(x float64) (float64, error) {
+		xs = append(xs, x)
+		return (x - 0.3) * (1 + x), nil
+	}
+	for _, guess := range []float64{0.29, 0.31, 0, 1} {
+		xs = xs[:0]
+		rootX, err := root.FindWithGuess(f, 0, 1, guess)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-5 < math.Abs(rootX-0.3) {
+			t.Errorf("not valid root for guess %.2f: %e", guess, rootX)
+		}
+		first := guess
+		if guess == 0 || guess == 1 {
+			first = 0.5
+		}
+		// function is run for left border, the first point and
+		// right border
+		if len(xs) < 3 || xs[1] != first {
+			t.Errorf("first point is not guess %.2f: %v", guess, xs)
+		}
+	}
+	for _, guess := range []float64{-0.1, 1.1, math.NaN()} {
+		if _, err := root.FindWithGuess(f, 0, 1, guess); err == nil {
+			t.Errorf("guess outside of interval is accepted: %e", guess)
+		}
+	}
+	// borders in reverse order
+	if _, err := root.FindWithGuess(f, 1, 0, 0.4); err != nil {
+		t.Errorf("guess inside of reverse interval: %v", err)
+	}
+}
+
+func TestReturnBest(t *testing.T) {
+	// the first middle point is near the root
+	f := func(x float64) (float64, error) {
+		return (x - 0.5000000001) * (1 + x), nil
+	}
+	res, err := root.FindResult(f, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	final, _ := f(res.Root)
+	t.Logf("root = %.12e, best = %.12e", res.Root, res.BestX)
+	if math.Abs(final) <= res.BestResidual {
+		t.Fatalf("final residual is not worse: %e <= %e", math.Abs(final), res.BestResidual)
+	}
+	if res.BestX != 0.5 {
+		t.Errorf("not valid best X: %e", res.BestX)
+	}
+	rootX, err := root.FindWith(root.Config{ReturnBest: true}, f, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rootX != res.BestX {
+		t.Errorf("best X is not returned: %e", rootX)
+	}
+}
+
+func TestConcurrentEval(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		inflight int
+		maximal  int
+	)
+	f := func(x float64) (float64, error) {
+		mutex.Lock()
+		inflight++
+		if maximal < inflight {
+			maximal = inflight
+		}
+		mutex.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mutex.Lock()
+		inflight--
+		mutex.Unlock()
+		return tcs[0].f(x), nil
+	}
+	rootX, err := root.FindWith(root.Config{ConcurrentEval: true}, f, tcs[0].Xmin, tcs[0].Xmax)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect, err := root.Find(f, tcs[0].Xmin, tcs[0].Xmax)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rootX != expect {
+		t.Errorf("roots are not same: %e != %e", rootX, expect)
+	}
+	if maximal < 2 {
+		t.Errorf("function is not run concurrently")
+	}
+	t.Run("panic", func(t *testing.T) {
+		p := func(float64) (float64, error) {
+			panic("PANIC")
+		}
+		_, err := root.FindWith(root.Config{ConcurrentEval: true}, p, 0, 1)
+		t.Logf("%v", err)
+		if err == nil {
+			t.Fatalf("Cannot panic finding")
+		}
+	})
+}
+
+func TestAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = root.Find(func(x float64) (float64, error) {
+			return tcs[0].f(x), nil
+		}, tcs[0].Xmin, tcs[0].Xmax)
+	})
+	if allocs != 0 {
+		t.Errorf("not valid amount of allocations: %.1f", allocs)
+	}
+}
+
+func TestTerminateOnWidthOnly(t *testing.T) {
+	// function with jump in the root
+	var calls int
+	f := func(x float64) (float64, error) {
+		calls++
+		if x < 0.3 {
+			return x - 0.3 - 1e-3, nil
+		}
+		return x - 0.3 + 1e-3, nil
+	}
+	// residual is never small, so searching is stopped
+	// only by float64 resolution
+	if _, err := root.Find(f, 0, 1); err == nil {
+		t.Fatalf("residual is small")
+	}
+	full := calls
+	calls = 0
+	rootX, err := root.FindWith(root.Config{TerminateOnWidthOnly: true}, f, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 1e-5 < math.Abs(rootX-0.3) {
+		t.Errorf("not valid root: %e", rootX)
+	}
+	if full <= calls {
+		t.Errorf("amount of calls is not less: %d <= %d", full, calls)
+	}
+}
+
+func TestMinInterval(t *testing.T) {
+	if w := root.MinInterval(1.0); w != math.Nextafter(1, 2)-1 {
+		t.Errorf("not valid width: %e", w)
+	}
+	var calls int
+	f := func(x float64) (float64, error) {
+		calls++
+		return x*x - 1000001, nil
+	}
+	// residual is not achievable, so searching is stopped at float64
+	// resolution with error
+	rootX, err := root.FindWith(root.Config{Precision: 1e-20}, f, 900, 1100)
+	t.Logf("%v", err)
+	var ef root.ErrorFind
+	if !errors.As(err, &ef) || ef.Type != root.Discontinuity {
+		t.Errorf("not valid error: %v", err)
+	}
+	if calls >= root.MaxIteration {
+		t.Errorf("too many calls: %d", calls)
+	}
+	if 2*root.MinInterval(rootX) < math.Abs(rootX-math.Sqrt(1000001)) {
+		t.Errorf("not valid root: %.16e", rootX)
+	}
+	// tolerance of X only
+	rootX, err = root.FindWith(root.Config{
+		Tolerance: root.Tolerance{AbsX: 1e-20},
+	}, f, 900, 1100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 2*root.MinInterval(rootX) < math.Abs(rootX-math.Sqrt(1000001)) {
+		t.Errorf("not valid root: %.16e", rootX)
+	}
+}
+
+func TestErrorFormat(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return x*x + 1, nil
+	}
+	for _, format := range []root.ErrorFormat{root.Detailed, root.Terse} {
+		_, err := root.FindWith(root.Config{ErrorFormat: format}, f, -2, 3)
+		if err == nil {
+			t.Fatalf("root is found")
+		}
+		t.Logf("%d: %v", format, err)
+		msg := err.Error()
+		if !strings.Contains(msg, "-2.") || !strings.Contains(msg, "3.") {
+			t.Errorf("interval is not in error message: %s", msg)
+		}
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.InternalErr {
+			t.Errorf("not valid error type: %v", err)
+		}
+	}
+	// error of function
+	errFunc := errors.New("function error")
+	g := func(x float64) (float64, error) {
+		return 0, errFunc
+	}
+	for _, format := range []root.ErrorFormat{root.Detailed, root.Terse} {
+		_, err := root.FindWith(root.Config{ErrorFormat: format}, g, 1, 2)
+		if !errors.Is(err, errFunc) {
+			t.Errorf("error is not unwrapped: %v", err)
+		}
+	}
+}
+
+func TestAbortOnResidualIncrease(t *testing.T) {
+	// bisection points: 0.5, 0.25, 0.375 with residuals 0.2, 0.05, 0.075
+	f := func(x float64) (float64, error) {
+		return x - 0.3, nil
+	}
+	if _, err := root.Find(f, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	_, err := root.FindWith(root.Config{AbortOnResidualIncrease: true}, f, 0, 1)
+	t.Logf("%v", err)
+	var ef root.ErrorFind
+	if !errors.As(err, &ef) || ef.Type != root.InternalErr {
+		t.Fatalf("not valid error: %v", err)
+	}
+	if !strings.Contains(err.Error(), "residual increased") {
+		t.Errorf("not valid error message: %v", err)
+	}
+}
+
+func TestSetupError(t *testing.T) {
+	errFunc := errors.New("domain error")
+	for _, tc := range []struct {
+		x      float64
+		origin string
+	}{
+		{0, "f(minX)"},
+		{4, "f(maxX)"},
+		{2, "f(middle)"},
+	} {
+		f := func(x float64) (float64, error) {
+			if x == tc.x {
+				return 0, errFunc
+			}
+			return x - 1, nil
+		}
+		_, err := root.Find(f, 0, 4)
+		t.Logf("%v", err)
+		if !errors.Is(err, errFunc) {
+			t.Errorf("error is not unwrapped: %v", err)
+		}
+		if err == nil || !strings.Contains(err.Error(), "error evaluating "+tc.origin) {
+			t.Errorf("origin %s is not in error: %v", tc.origin, err)
+		}
+	}
+}
+
+func TestToleranceFunc(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return x - 100.3, nil
+	}
+	tol := func(x float64) float64 {
+		// tolerance is tighter for bigger values
+		return 1e-4 / (1 + math.Abs(x))
+	}
+	rootX, err := root.FindWith(root.Config{
+		ToleranceFunc:        tol,
+		TerminateOnWidthOnly: true,
+	}, f, 0, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tol(rootX) < math.Abs(rootX-100.3) {
+		t.Errorf("not valid root: %.10e", rootX)
+	}
+	t.Run("not valid", func(t *testing.T) {
+		_, err := root.FindWith(root.Config{
+			ToleranceFunc: func(x float64) float64 { return 0 },
+		}, f, 0, 1000)
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.NotValidValue {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
+
+func TestTolerance(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return (x - 0.3) * (1 + x), nil
+	}
+	t.Run("AbsY", func(t *testing.T) {
+		rootX, err := root.FindWith(root.Config{
+			Tolerance: root.Tolerance{AbsY: 1e-9},
+		}, f, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if y, _ := f(rootX); 1e-9 <= math.Abs(y) {
+			t.Errorf("not valid residual: %e", y)
+		}
+	})
+	t.Run("AbsX", func(t *testing.T) {
+		res, err := root.FindResult(f, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var iterations int
+		rootX, err := root.FindWith(root.Config{
+			Tolerance: root.Tolerance{AbsX: 1e-2},
+			OnStep: func(iter int, _, _, _ float64) {
+				iterations = iter
+			},
+		}, f, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-2 < math.Abs(rootX-0.3) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+		if res.Iterations <= iterations {
+			t.Errorf("residual is checked: %d <= %d", res.Iterations, iterations)
+		}
+	})
+	t.Run("not valid", func(t *testing.T) {
+		_, err := root.FindWith(root.Config{
+			Tolerance: root.Tolerance{AbsY: -1},
+		}, f, 0, 1)
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.NotValidValue {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
+
+func TestConverged(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return x - 100.3, nil
+	}
+	// convergence in logarithmic scale of X
+	converged := func(iter int, x, y, width float64) bool {
+		return width/x < 1e-3
+	}
+	var iterations int
+	res, err := root.FindResultWith(root.Config{
+		Converged: converged,
+		OnStep: func(iter int, _, _, _ float64) {
+			iterations = iter
+		},
+	}, f, 1, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 1e-3*res.Root < res.Upper-res.Lower {
+		t.Errorf("not converged interval: [%e, %e]", res.Lower, res.Upper)
+	}
+	if 0.1 < math.Abs(res.Root-100.3) {
+		t.Errorf("not valid root: %e", res.Root)
+	}
+	if iterations == 0 {
+		t.Errorf("linear interpolation is used")
+	}
+	t.Run("max iteration", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return (x - 0.3) * (1 + x), nil
+		}
+		_, err := root.FindWith(root.Config{
+			MaxIteration: 10,
+			Converged: func(int, float64, float64, float64) bool {
+				return false
+			},
+		}, g, 0, 1)
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.MaximalIteration {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
+
+func TestDuration(t *testing.T) {
+	const pause = 10 * time.Microsecond
+	f := func(x float64) (float64, error) {
+		time.Sleep(pause)
+		return x - 0.3, nil
+	}
+	res, err := root.FindResult(f, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("duration: %v", res.Duration)
+	if res.Duration < time.Duration(res.Evaluations)*pause {
+		t.Errorf("not valid duration: %v for %d evaluations",
+			res.Duration, res.Evaluations)
+	}
+	t.Run("not requested", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return x - 0.3, nil
+		}
+		// statistics are not collected by Find
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = root.Find(g, 0, 1)
+		})
+		if allocs != 0 {
+			t.Errorf("not valid amount of allocations: %.1f", allocs)
+		}
+		// only requested statistics are populated
+		res, err := root.FindResultWith(root.Config{}, g, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Duration <= 0 || res.Evaluations == 0 {
+			t.Errorf("statistics are not populated: %v, %d",
+				res.Duration, res.Evaluations)
+		}
+		if res.History != nil {
+			t.Errorf("history is populated: %d", len(res.History))
+		}
+	})
+}
+
+func TestConstant(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return 5, nil
+	}
+	_, err := root.Find(f, -3, 7)
+	t.Logf("%v", err)
+	if !errors.Is(err, root.ErrConstant) {
+		t.Errorf("not valid error: %v", err)
+	}
+	var ef root.ErrorFind
+	if !errors.As(err, &ef) || ef.Type != root.InternalErr {
+		t.Errorf("not valid error type: %v", err)
+	}
+}
+
+func TestExpectedDirection(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return x - 0.3, nil
+	}
+	for _, tc := range []struct {
+		f    func(float64) (float64, error)
+		d    root.Direction
+		isOk bool
+	}{
+		{f, root.Any, true},
+		{f, root.NegToPos, true},
+		{f, root.PosToNeg, false},
+		{root.Negate(f), root.PosToNeg, true},
+		{root.Negate(f), root.NegToPos, false},
+	} {
+		_, err := root.FindWith(root.Config{ExpectedDirection: tc.d}, tc.f, 1, 0)
+		if tc.isOk {
+			if err != nil {
+				t.Errorf("%s: %v", tc.d, err)
+			}
+			continue
+		}
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.NotValidValue {
+			t.Errorf("%s: not valid error: %v", tc.d, err)
+		}
+	}
+}
+
+func TestStagnation(t *testing.T) {
+	// false position moves by one ULP on each iteration
+	f := func(x float64) (float64, error) {
+		if x < 0.5 {
+			return -1e-16, nil
+		}
+		return 1, nil
+	}
+	rootX, err := root.FindWith(root.Config{
+		Method:    root.FalsePosition,
+		Precision: 1e-20,
+	}, f, 0.25, 1)
+	t.Logf("%v", err)
+	var ef root.ErrorFind
+	if !errors.As(err, &ef) || ef.Type != root.Stalled {
+		t.Fatalf("not valid error: %v", err)
+	}
+	if !strings.Contains(err.Error(), "search stagnated") {
+		t.Errorf("not valid error message: %v", err)
+	}
+	// best estimation is returned
+	if y, _ := f(rootX); y != -1e-16 {
+		t.Errorf("not valid estimation: %e", rootX)
+	}
+}
+
+func TestDiscontinuity(t *testing.T) {
+	// function changes sign by jump without root
+	f := func(x float64) (float64, error) {
+		if x < 0.3 {
+			return -1, nil
+		}
+		return 1, nil
+	}
+	for _, m := range []root.Method{root.Bisection, root.Illinois, root.AndersonBjorck} {
+		rootX, err := root.FindWith(root.Config{Method: m}, f, 0, 1)
+		t.Logf("%s: %v", m, err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.Discontinuity {
+			t.Errorf("%s: not valid error: %v", m, err)
+		}
+		if 1e-6 < math.Abs(rootX-0.3) {
+			t.Errorf("%s: not valid point of jump: %e", m, rootX)
+		}
+	}
+	// tolerance of X is less float64 resolution
+	for _, cfg := range []root.Config{
+		{Precision: 1e-20},
+		{Tolerance: root.Tolerance{AbsX: 1e-30, AbsY: 1e-9}},
+	} {
+		rootX, err := root.FindWith(cfg, f, 0, 1)
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.Discontinuity {
+			t.Errorf("%#v: not valid error: %v", cfg.Tolerance, err)
+		}
+		if 1e-6 < math.Abs(rootX-0.3) {
+			t.Errorf("not valid point of jump: %e", rootX)
+		}
+	}
+	// residual is not checked
+	if _, err := root.FindWith(root.Config{TerminateOnWidthOnly: true}, f, 0, 1); err != nil {
+		t.Errorf("residual is checked: %v", err)
+	}
+}
+
+func TestScaleByEndpoints(t *testing.T) {
+	// function of Case 21
+	tf := root.TestFunctions()[21]
+	calls := func(cfg root.Config, factor float64) int {
+		var counter int
+		f := func(x float64) (float64, error) {
+			counter++
+			y, err := tf.F(x)
+			return factor * y, err
+		}
+		if _, err := root.FindWith(cfg, f, tf.Min, tf.Max); err != nil {
+			t.Fatal(err)
+		}
+		return counter
+	}
+	var (
+		absolute = [2]int{
+			calls(root.Config{}, 1),
+			calls(root.Config{}, 1e6),
+		}
+		scaled = [2]int{
+			calls(root.Config{ScaleByEndpoints: true}, 1),
+			calls(root.Config{ScaleByEndpoints: true}, 1e6),
+		}
+	)
+	t.Logf("absolute: %v, scaled: %v", absolute, scaled)
+	if absolute[0] == absolute[1] {
+		t.Errorf("absolute tolerance is independent from units")
+	}
+	if scaled[0] != scaled[1] {
+		t.Errorf("scaled tolerance depends on units")
+	}
+}
+
+func TestTrace(t *testing.T) {
+	t.Run("table", func(t *testing.T) {
+		// table from documentation of MaxIteration
+		content, err := os.ReadFile("root.go")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var (
+			expect strings.Builder
+			table  bool
+		)
+		for _, line := range strings.Split(string(content), "\n") {
+			line = strings.TrimPrefix(strings.TrimSpace(line), "//")
+			if strings.HasPrefix(line, "\tIt.") {
+				table = true
+				line = strings.TrimPrefix(line, "\t")
+			}
+			if !table {
+				continue
+			}
+			if strings.HasPrefix(line, "MaxIteration") {
+				break
+			}
+			expect.WriteString(line + "\n")
+		}
+		if expect.Len() == 0 {
+			t.Fatalf("table is not found")
+		}
+		tf := root.TestFunctions()[26]
+		var actual strings.Builder
+		_, err = root.FindWith(root.Config{Trace: &actual, TableFormat: true}, tf.F, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// documented table is without the last iteration with
+		// converged solution
+		out := actual.String()
+		if i := strings.LastIndex(strings.TrimSuffix(out, "\n"), "\n"); 0 <= i {
+			out = out[:i+1]
+		}
+		if out != expect.String() {
+			t.Errorf("not same table:\n%s\n%s", out, expect.String())
+		}
+	})
+	t.Run("default", func(t *testing.T) {
+		var actual strings.Builder
+		f := func(x float64) (float64, error) {
+			return x - 0.3, nil
+		}
+		res, err := root.FindResult(f, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = root.FindWith(root.Config{Trace: &actual}, f, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("\n%s", actual.String())
+		if lines := strings.Count(actual.String(), "\n"); lines != res.Iterations+1 {
+			t.Errorf("not valid amount of lines: %d != %d", lines, res.Iterations+1)
+		}
+	})
+	t.Run("zero border", func(t *testing.T) {
+		var actual strings.Builder
+		f := func(x float64) (float64, error) {
+			return (x + 0.3) * (x + 2), nil
+		}
+		_, err := root.FindWith(root.Config{Trace: &actual, TableFormat: true}, f, -1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("\n%s", actual.String())
+		if out := actual.String(); strings.Contains(out, "Inf") || strings.Contains(out, "NaN") {
+			t.Errorf("not valid relative width")
+		}
+	})
+}
+
+func TestOnStep(t *testing.T) {
+	tf := root.TestFunctions()[26]
+	var expect strings.Builder
+	_, err := root.FindWith(root.Config{Trace: &expect, TableFormat: true}, tf.F, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actual strings.Builder
+	fmt.Fprintf(&actual, "%-5s%-16s%-16s%s\n", "It.", "X value", "Y value", "Xerror")
+	_, err = root.FindWith(root.Config{
+		OnStep: func(iter int, x, y, xerr float64) {
+			fmt.Fprintf(&actual, "%3d%16.6e%16.6e%16.6e\n", iter, x, y, xerr)
+		},
+	}, tf.F, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual.String() != expect.String() {
+		t.Errorf("not same table:\n%s\n%s", actual.String(), expect.String())
+	}
+	t.Run("zero border", func(t *testing.T) {
+		for _, f := range []func(float64) (float64, error){
+			func(x float64) (float64, error) { return (x + 0.3) * (x + 2), nil },
+			func(x float64) (float64, error) { return x + 0.3, nil },
+		} {
+			_, err := root.FindWith(root.Config{
+				OnStep: func(iter int, x, y, xerr float64) {
+					if math.IsInf(xerr, 0) || math.IsNaN(xerr) {
+						t.Errorf("not valid relative width on iteration %d: %e", iter, xerr)
+					}
+				},
+			}, f, -1, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestSafeMode(t *testing.T) {
+	for _, m := range []root.Method{root.Bisection, root.FalsePosition} {
+		for i := range tcs {
+			f := func(x float64) (float64, error) {
+				return tcs[i].f(x), nil
+			}
+			rootX, err := root.FindWith(root.Config{Method: m, SafeMode: true}, f, tcs[i].Xmin, tcs[i].Xmax)
+			if err != nil {
+				if strings.Contains(err.Error(), "bracket invariant violated") {
+					t.Errorf("%s: Case %d: %v", m, i, err)
+				}
+				continue
+			}
+			expect, _ := root.FindWith(root.Config{Method: m}, f, tcs[i].Xmin, tcs[i].Xmax)
+			if rootX != expect {
+				t.Errorf("%s: Case %d: not same root", m, i)
+			}
+		}
+	}
+}
+
+func TestFlatZero(t *testing.T) {
+	// function with zero values on interval [0.2, 0.6]
+	f := func(x float64) (float64, error) {
+		switch {
+		case x < 0.2:
+			return x - 0.2, nil
+		case x < 0.6:
+			return 0, nil
+		}
+		return x - 0.6, nil
+	}
+	for _, g := range []func(float64) (float64, error){f, root.Negate(f)} {
+		for _, m := range []root.Method{root.Bisection, root.FalsePosition} {
+			rootX, err := root.FindWith(root.Config{Method: m}, g, 0, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if 1e-5 < math.Abs(rootX-0.2) {
+				t.Errorf("%s: root is not leftmost: %e", m, rootX)
+			}
+		}
+	}
+}
+
+func TestExactZero(t *testing.T) {
+	// middle of interval [-1, 1] is exact root with positive
+	// and negative zero values of function
+	for _, zero := range []float64{0, math.Copysign(0, -1)} {
+		f := func(x float64) (float64, error) {
+			if x == 0 {
+				return zero, nil
+			}
+			return x, nil
+		}
+		for _, g := range []func(float64) (float64, error){f, root.Negate(f)} {
+			for _, m := range []root.Method{root.Bisection, root.FalsePosition} {
+				rootX, err := root.FindWith(root.Config{Method: m}, g, -1, 1)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if rootX != 0 {
+					t.Errorf("%s: root is not exact for zero %v: %e",
+						m, math.Signbit(zero), rootX)
+				}
+			}
+		}
+	}
+}
+
+func TestBothEndpointsRoots(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return math.Sin(math.Pi * x), nil
+	}
+	rootX, err := root.Find(f, 0, 1)
+	if err != nil || rootX != 0 {
+		t.Errorf("default: %e %v", rootX, err)
+	}
+	rootX, err = root.FindWith(root.Config{BothEndpointsRoots: root.ReturnLeft}, f, 0, 1)
+	if err != nil || rootX != 0 {
+		t.Errorf("left: %e %v", rootX, err)
+	}
+	rootX, err = root.FindWith(root.Config{BothEndpointsRoots: root.ReturnRight}, f, 0, 1)
+	if err != nil || rootX != 1 {
+		t.Errorf("right: %e %v", rootX, err)
+	}
+	_, err = root.FindWith(root.Config{BothEndpointsRoots: root.ReturnError}, f, 0, 1)
+	t.Logf("%v", err)
+	if err == nil {
+		t.Errorf("error is not returned")
+	}
+}
+
+func TestEvalLog(t *testing.T) {
+	var xs []float64
+	f := func(x float64) (float64, error) {
+		xs = append(xs, x)
+		return x*x - 2, nil
+	}
+	var log []root.EvalRecord
+	rootX, err := root.FindWith(root.Config{EvalLog: &log}, f, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log) != len(xs) {
+		t.Fatalf("not valid amount of records: %d != %d", len(log), len(xs))
+	}
+	for i := range log {
+		if log[i].X != xs[i] || log[i].Y != xs[i]*xs[i]-2 || log[i].Err != nil {
+			t.Errorf("not valid record %d: %v", i, log[i])
+		}
+	}
+	// last call is confirming call for the root
+	if log[len(log)-1].X != rootX {
+		t.Errorf("last call is not for the root: %v", log[len(log)-1])
+	}
+}
+
+func TestHistory(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return x*x - 2, nil
+	}
+	var log []root.EvalRecord
+	res, err := root.FindResultWith(root.Config{EvalLog: &log, History: true}, f, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.History) != res.Evaluations || len(res.History) != len(log) {
+		t.Fatalf("not valid amount of records: %d != %d",
+			len(res.History), res.Evaluations)
+	}
+	for i := range log {
+		if res.History[i] != log[i] {
+			t.Errorf("not valid record %d: %v != %v", i, res.History[i], log[i])
+		}
+	}
+	// amount of records is limited by MaxIteration
+	res, _ = root.FindResultWith(root.Config{MaxIteration: 5, History: true}, f, 0, 2)
+	if len(res.History) != 5 {
+		t.Errorf("not valid limit of records: %d", len(res.History))
+	}
+	// history is not stored by default
+	if res, _ = root.FindResult(f, 0, 2); res.History != nil {
+		t.Errorf("history is stored by default")
+	}
+}
+
+func TestFindResidualHistory(t *testing.T) {
+	for i := range tcs {
+		f := func(x float64) (float64, error) {
+			return tcs[i].f(x), nil
+		}
+		res, err := root.FindResult(f, tcs[i].Xmin, tcs[i].Xmax)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rootX, residuals, err := root.FindResidualHistory(f, tcs[i].Xmin, tcs[i].Xmax)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rootX != res.Root {
+			t.Errorf("Case %d: not same root", i)
+		}
+		if len(residuals) != res.Iterations {
+			t.Errorf("Case %d: not valid length of history: %d != %d",
+				i, len(residuals), res.Iterations)
+		}
+		if 0 < len(residuals) && root.Precision <= residuals[len(residuals)-1] {
+			t.Errorf("Case %d: not valid last residual: %e",
+				i, residuals[len(residuals)-1])
+		}
+	}
+}
+
+func TestMaxIterationResidual(t *testing.T) {
+	// flat function with small residual on wide interval
+	f := func(x float64) (float64, error) {
+		return 1e-3 * (x - 0.3) * (1 + 10*x), nil
+	}
+	_, residuals, err := root.FindResidualHistory(f, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := -1
+	for i, r := range residuals {
+		if r < root.Precision {
+			last = i
+			break
+		}
+	}
+	if last < 1 || last == len(residuals)-1 {
+		t.Fatalf("not valid residuals: %v", residuals)
+	}
+	// residual is acceptable at the last iteration
+	rootX, err := root.FindWith(root.Config{MaxIteration: last + 1}, f, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if y, _ := f(rootX); root.Precision <= math.Abs(y) {
+		t.Errorf("not valid residual: %e", y)
+	}
+	// residual is not acceptable
+	_, err = root.FindWith(root.Config{MaxIteration: last}, f, 0, 1)
+	var ef root.ErrorFind
+	if !errors.As(err, &ef) || ef.Type != root.MaximalIteration {
+		t.Errorf("not valid error: %v", err)
+	}
+}
+
+func TestAbsRelTol(t *testing.T) {
+	t.Run("zero root", func(t *testing.T) {
+		// absolute tolerance is dominated
+		f := func(x float64) (float64, error) {
+			return x, nil
+		}
+		rootX, err := root.FindWith(root.Config{AbsTol: 1e-9, RelTol: 1e-9}, f, -1, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-9 < math.Abs(rootX) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("big root", func(t *testing.T) {
+		// relative tolerance is dominated
+		f := func(x float64) (float64, error) {
+			return x - 1000.5, nil
+		}
+		rootX, err := root.FindWith(root.Config{AbsTol: 1e-12, RelTol: 1e-12}, f, 0, 2000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-12*1000.5 < math.Abs(rootX-1000.5) {
+			t.Errorf("not valid root: %.16e", rootX)
+		}
+	})
+	t.Run("not valid", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return x, nil
+		}
+		_, err := root.FindWith(root.Config{AbsTol: -1}, f, -1, 2)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.NotValidValue {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
+
+func TestErrorFloatFormat(t *testing.T) {
+	defer func(format string) {
+		root.ErrorFloatFormat = format
+	}(root.ErrorFloatFormat)
+	root.ErrorFloatFormat = "%.17g"
+
+	f := func(x float64) (float64, error) {
+		return x*x + 0.1, nil
+	}
+	_, err := root.Find(f, 0.1, 0.3)
+	if err == nil {
+		t.Fatalf("root is found")
+	}
+	t.Logf("%v", err)
+	for _, v := range []string{"0.10000000000000001", "0.29999999999999999", "0.11"} {
+		if !strings.Contains(err.Error(), v) {
+			t.Errorf("value %s is not found in error", v)
+		}
+	}
+	if strings.Contains(err.Error(), "e-01") {
+		t.Errorf("default format is used")
+	}
+}
+
+func TestFindWithConcurrent(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return x*x - 2, nil
+	}
+	precisions := []float64{1e-2, 1e-4, 1e-8, 1e-12}
+	var (
+		wg    sync.WaitGroup
+		roots = make([]float64, len(precisions))
+		errs  = make([]error, len(precisions))
+	)
+	for i := range precisions {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			roots[i], errs[i] = root.FindWith(root.Config{
+				Precision: precisions[i],
+				Method:    root.Bisection,
+			}, f, 0, 2)
+		}(i)
+	}
+	wg.Wait()
+	for i := range precisions {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		if y, _ := f(roots[i]); precisions[i] <= math.Abs(y) {
+			t.Errorf("precision %e: not valid root %e", precisions[i], roots[i])
+		}
+	}
+}
+
+func TestLinear(t *testing.T) {
+	for _, i := range []int{28, 29} {
+		tf := root.TestFunctions()[i]
+		res, err := root.FindResult(tf.F, tf.Min, tf.Max)
+		if err != nil {
+			t.Fatalf("Case %d: %v", i, err)
+		}
+		t.Logf("Case %d: iterations %d, evaluations %d",
+			i, res.Iterations, res.Evaluations)
+		if res.Iterations != 0 || 4 < res.Evaluations {
+			t.Errorf("Case %d: not converged in the setup", i)
+		}
+	}
+	t.Run("interior", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return 2*x - 3, nil
+		}
+		res, err := root.FindResult(f, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("iterations %d, evaluations %d", res.Iterations, res.Evaluations)
+		if res.Iterations != 0 || 5 < res.Evaluations {
+			t.Errorf("not converged in the setup")
+		}
+		if res.Root != 1.5 {
+			t.Errorf("not valid root: %.16e", res.Root)
+		}
+		rootX, err := root.FindWith(root.Config{SafeMode: true}, f, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rootX != 1.5 {
+			t.Errorf("not valid root in safe mode: %.16e", rootX)
+		}
+	})
+	t.Run("not linear", func(t *testing.T) {
+		// function is linear only in points 0, 0.5 and 1
+		f := func(x float64) (float64, error) {
+			return x - 0.3 + x*(x-0.5)*(x-1), nil
+		}
+		var log []root.EvalRecord
+		res, err := root.FindResultWith(root.Config{EvalLog: &log}, f, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the fourth call is in the point of linear interpolation
+		if len(log) < 4 || 1e-12 < math.Abs(log[3].X-0.3) {
+			t.Fatalf("interpolation is not used: %v", log)
+		}
+		// interval is not widened after interpolation
+		for _, r := range log[4:] {
+			if 0.3 < r.X {
+				t.Errorf("point %e is outside of interval [0, 0.3]", r.X)
+			}
+		}
+		rootX, err := root.FindWith(root.Config{SafeMode: true}, f, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rootX != res.Root {
+			t.Errorf("not same root in safe mode: %e != %e", rootX, res.Root)
+		}
+	})
+	t.Run("tolerance", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return 2*x - 3, nil
+		}
+		var calls int
+		rootX, err := root.FindWith(root.Config{
+			ToleranceFunc: func(x float64) float64 {
+				calls++
+				return 1e-3
+			},
+		}, f, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if calls == 0 {
+			t.Errorf("tolerance function is not used")
+		}
+		if 1e-3 < math.Abs(rootX-1.5) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+}
+
+func TestDefaultPrecision(t *testing.T) {
+	type Meter float64
+	prec := root.DefaultPrecision[float64]()
+	if float64(root.DefaultPrecision[Meter]()) != prec {
+		t.Errorf("precision is not same for underlying type")
+	}
+	if eps := math.Nextafter(1, 2) - 1; prec != math.Sqrt(eps) {
+		t.Errorf("not valid precision: %e", prec)
+	}
+	f := func(x float64) (float64, error) {
+		return (x - 0.3) * (1 + x), nil
+	}
+	rootX, err := root.FindWith(root.Config{Precision: prec}, f, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if y, _ := f(rootX); prec < math.Abs(y) {
+		t.Errorf("not valid precision: %e", y)
+	}
+}
+
+func TestSetPrecision(t *testing.T) {
+	defer func(prec float64, maxIter int) {
+		root.Precision, root.MaxIteration = prec, maxIter
+	}(root.Precision, root.MaxIteration)
+
+	f := func(x float64) (float64, error) {
+		return (x - 0.3) * (1 + x), nil
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if _, err := root.Find(f, 0, 1); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	for j := 0; j < 100; j++ {
+		if err := root.SetPrecision(1e-6 * float64(1+j%3)); err != nil {
+			t.Fatal(err)
+		}
+		if err := root.SetMaxIteration(500 + j); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wg.Wait()
+	if err := root.SetPrecision(-1); err == nil {
+		t.Errorf("negative precision is accepted")
+	}
+	if err := root.SetMaxIteration(0); err == nil {
+		t.Errorf("zero max iteration is accepted")
+	}
+}
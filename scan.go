@@ -0,0 +1,118 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// DetectOscillation returns true, if function oscillates on interval
+// [minX, maxX] too fast for the amount of samples. In that case the sign
+// of function on samples is aliased, and result of root-finding by
+// bisection is not reliable.
+//
+// Function is run for samples uniformly spaced on interval, and the
+// amount of sign changes between neighbor samples is calculated.
+// Heuristic: oscillation is detected, when there is more one sign change
+// per four samples, in other words less four samples per half-period of
+// oscillation.
+//
+// Notes:
+//   - Panic-free function
+func DetectOscillation(f func(float64) (float64, error), minX, maxX float64, samples int) (oscillation bool, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	xs, ys, err := Sample(f, minX, maxX, samples)
+	if err != nil {
+		return
+	}
+	var changes int
+	for i := 1; i < len(xs); i++ {
+		if math.Signbit(ys[i]) != math.Signbit(ys[i-1]) {
+			changes++
+		}
+	}
+	return samples < 4*changes, nil
+}
+
+// Sample returns values of function on n samples uniformly spaced on
+// interval [minX, maxX] including borders. Sampling is stopped on the
+// first error of function.
+//
+//	Input data:
+//		f    - function of variable X
+//		minX - minimal X
+//		maxX - maximal X
+//		n    - amount of samples, minimal 2
+//	Output data:
+//		xs   - X values of samples
+//		ys   - function values of samples
+//		err  - error if some is not ok
+func Sample(f func(float64) (float64, error), minX, maxX float64, n int) (xs, ys []float64, err error) {
+	if n < 2 {
+		err = ErrorFind{
+			Type: NotValidValue,
+			Err:  fmt.Errorf("amount of samples is not valid: %d", n),
+		}
+		return
+	}
+	xs = make([]float64, n)
+	ys = make([]float64, n)
+	for i := range xs {
+		xs[i] = minX + (maxX-minX)*float64(i)/float64(n-1)
+		if ys[i], err = f(xs[i]); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Brackets returns subintervals of interval [minX, maxX] with sign
+// change of function on borders. Function is run on n samples by
+// Sample. Zero value of function in sample between subintervals is
+// related to the right subinterval, same as in FindAllVerbose.
+//
+//	Input data:
+//		f        - function of variable X
+//		minX     - minimal X
+//		maxX     - maximal X
+//		n        - amount of samples, minimal 2
+//	Output data:
+//		brackets - subintervals with sign change in increasing order
+//		err      - error if some is not ok
+func Brackets(f func(float64) (float64, error), minX, maxX float64, n int) (brackets [][2]float64, err error) {
+	// replace borders
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	xs, ys, err := Sample(f, minX, maxX, n)
+	if err != nil {
+		return
+	}
+	for i := 0; i < len(xs)-1; i++ {
+		if signChange(ys, i) {
+			brackets = append(brackets, [2]float64{xs[i], xs[i+1]})
+		}
+	}
+	return
+}
+
+// signChange returns true, when function have sign change on borders
+// of subinterval between samples i and i+1. Zero value of function in
+// sample between subintervals is related to the right subinterval.
+func signChange(ys []float64, i int) bool {
+	var (
+		left  = ys[i]
+		rigth = ys[i+1]
+		last  = i == len(ys)-2
+	)
+	return left == 0 ||
+		(rigth != 0 && math.Signbit(left) != math.Signbit(rigth)) ||
+		(rigth == 0 && last)
+}
@@ -0,0 +1,146 @@
+package root_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestDetectOscillation(t *testing.T) {
+	t.Run("sin", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return math.Sin(100 * x), nil
+		}
+		oscillation, err := root.DetectOscillation(f, 0, 1, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !oscillation {
+			t.Errorf("oscillation is not detected")
+		}
+	})
+	t.Run("monotone", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return math.Exp(x) - 2, nil
+		}
+		oscillation, err := root.DetectOscillation(f, 0, 1, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if oscillation {
+			t.Errorf("oscillation is detected for monotone function")
+		}
+	})
+	t.Run("samples", func(t *testing.T) {
+		f := func(x float64) (float64, error) {
+			return x, nil
+		}
+		if _, err := root.DetectOscillation(f, 0, 1, 1); err == nil {
+			t.Errorf("not valid amount of samples is accepted")
+		}
+	})
+}
+
+func TestSample(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return math.Sin(x), nil
+	}
+	xs, ys, err := root.Sample(f, 1, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(xs) != 5 || len(ys) != 5 {
+		t.Fatalf("not valid amount of samples: %d %d", len(xs), len(ys))
+	}
+	for i := range xs {
+		if x := 1 + 0.5*float64(i); xs[i] != x {
+			t.Errorf("not valid grid: %e != %e", xs[i], x)
+		}
+		if y, _ := f(xs[i]); ys[i] != y {
+			t.Errorf("not valid value: %e != %e", ys[i], y)
+		}
+	}
+	t.Run("error", func(t *testing.T) {
+		var calls int
+		errFunc := errors.New("function error")
+		g := func(x float64) (float64, error) {
+			calls++
+			if 2 < x {
+				return 0, errFunc
+			}
+			return x, nil
+		}
+		if _, _, err := root.Sample(g, 1, 3, 5); !errors.Is(err, errFunc) {
+			t.Errorf("not valid error: %v", err)
+		}
+		if calls != 4 {
+			t.Errorf("sampling is not stopped on error: %d", calls)
+		}
+	})
+	t.Run("not valid", func(t *testing.T) {
+		if _, _, err := root.Sample(f, 1, 3, 1); err == nil {
+			t.Errorf("one sample is accepted")
+		}
+	})
+}
+
+func TestBrackets(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return math.Sin(x), nil
+	}
+	brackets, err := root.Brackets(f, 10, 0.5, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(brackets) != 3 {
+		t.Fatalf("not valid amount of brackets: %v", brackets)
+	}
+	for i, b := range brackets {
+		r := float64(i+1) * math.Pi
+		if !(b[0] < r && r < b[1]) {
+			t.Errorf("root %e is not in bracket %v", r, b)
+		}
+	}
+	t.Run("node", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return x - 0.5, nil
+		}
+		brackets, err := root.Brackets(g, 0, 1, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(brackets) != 1 || brackets[0] != [2]float64{0.5, 0.75} {
+			t.Errorf("root in node is not related to the right bracket: %v", brackets)
+		}
+	})
+	t.Run("same as FindAllVerbose", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return (x - 0.25) * (x - 0.5) * (x - 1), nil
+		}
+		brackets, err := root.Brackets(g, 0, 1, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, panels, signChanges, _, err := root.FindAllVerbose(g, 0, 1, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var expect [][2]float64
+		for i := range panels {
+			if signChanges[i] {
+				expect = append(expect, panels[i])
+			}
+		}
+		if fmt.Sprint(brackets) != fmt.Sprint(expect) {
+			t.Errorf("not same brackets: %v != %v", brackets, expect)
+		}
+	})
+	t.Run("not valid", func(t *testing.T) {
+		if _, err := root.Brackets(f, 0, 1, 1); err == nil {
+			t.Errorf("one sample is accepted")
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package root
+
+import (
+	"fmt"
+	"math"
+)
+
+// FindSecant finds root of function by secant method. Derivative of
+// function is not needed, next X is root of line through two last
+// points.
+//
+// Documentation: https://en.wikipedia.org/wiki/Secant_method
+//
+//	Input data:
+//		f    - function of variable X for root-finding
+//		x0   - first initial X
+//		x1   - second initial X
+//	Output data:
+//		root - root of function
+//		err  - error if some is not ok
+//
+// If absolute slope of line through two last points is less default
+// value of Config.MinSlope, then bisection is used, when the points have
+// different signs of function, otherwise returns ErrorFind with type
+// InternalErr. Root is not bracketed, so the method may diverge.
+//
+// Notes:
+//   - Panic-free function
+func FindSecant(f func(float64) (float64, error), x0, x1 float64) (root float64, err error) {
+	// recovering
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrorFind{
+				Type: Recovery,
+				Err:  fmt.Errorf("%#v", r),
+			}
+		}
+	}()
+	var (
+		cfg           = Config{}.defaults()
+		prec, maxIter = cfg.Precision, cfg.MaxIteration
+		minSlope      = cfg.MinSlope
+		y0, y1        float64
+	)
+	if err = checkPrecision(prec); err != nil {
+		return
+	}
+	if y0, err = f(x0); err != nil {
+		return
+	}
+	if y1, err = f(x1); err != nil {
+		return
+	}
+	for iter := 0; ; iter++ {
+		if y1 == 0 {
+			break // exact root
+		}
+		if iter >= maxIter {
+			err = ErrorFind{
+				Type: MaximalIteration,
+				Err:  fmt.Errorf("Too many iterations: %d", iter),
+			}
+			return
+		}
+		var (
+			step   float64
+			bisect bool
+			dY     = y1 - y0
+		)
+		if dY == 0 || math.Abs(dY/(x1-x0)) < minSlope {
+			if math.Signbit(y0) == math.Signbit(y1) {
+				err = ErrorFind{
+					Type: InternalErr,
+					Err: fmt.Errorf("too small slope for [%s, %s]: %s",
+						fmtFloat(x0), fmtFloat(x1), fmtFloat(dY)),
+				}
+				return
+			}
+			// root is bracketed, so bisection is used
+			step, bisect = (x0-x1)/2.0, true
+		} else {
+			step = -y1 * (x1 - x0) / dY
+		}
+		x := x1 + step
+		var y float64
+		if y, err = f(x); err != nil {
+			return
+		}
+		if err = checkValues(x, y); err != nil {
+			return
+		}
+		if !bisect || math.Signbit(y) != math.Signbit(y1) {
+			x0, y0 = x1, y1
+		}
+		x1, y1 = x, y
+		if math.Abs(y1) < prec && converged(step, x1, prec) {
+			break // find the solution
+		}
+	}
+	root = x1
+	return
+}
@@ -0,0 +1,75 @@
+package root_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestFindSecant(t *testing.T) {
+	var calls int
+	f := func(x float64) (float64, error) {
+		calls++
+		return x*x*x - 2*x - 5, nil
+	}
+	rootX, err := root.FindSecant(f, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if y, _ := f(rootX); root.Precision < math.Abs(y) {
+		t.Errorf("not valid root: %e", rootX)
+	}
+	secant := calls
+	res, err := root.FindResult(f, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("calls: secant %d, bisection %d", secant, res.Evaluations)
+	if res.Evaluations <= secant {
+		t.Errorf("secant method is not faster")
+	}
+	t.Run("zero denominator", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return x*x - 4, nil
+		}
+		_, err := root.FindSecant(g, -1, 1)
+		t.Logf("%v", err)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.InternalErr {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+	t.Run("flat slope", func(t *testing.T) {
+		// slope of line between initial points is less Precision
+		g := func(x float64) (float64, error) {
+			return math.Tanh(x - 0.3), nil
+		}
+		rootX, err := root.FindSecant(g, -1e7, 2e7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.IsNaN(rootX) || math.IsInf(rootX, 0) || 1e-5 < math.Abs(rootX-0.3) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("diverge", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			return math.Exp(-x) + 1, nil
+		}
+		if _, err := root.FindSecant(g, 0, 1); err == nil {
+			t.Errorf("root is found")
+		}
+	})
+	t.Run("panic", func(t *testing.T) {
+		g := func(x float64) (float64, error) {
+			panic("panic")
+		}
+		_, err := root.FindSecant(g, 0, 1)
+		var ef root.ErrorFind
+		if !errors.As(err, &ef) || ef.Type != root.Recovery {
+			t.Errorf("not valid error: %v", err)
+		}
+	})
+}
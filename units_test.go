@@ -0,0 +1,86 @@
+package root_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+type (
+	Meters  float64
+	Newtons float64
+)
+
+// spring is force of spring with stiffness 200 N/m and preload 50 N
+func spring(x Meters) (Newtons, error) {
+	return Newtons(200*x) - 50, nil
+}
+
+func TestUnits(t *testing.T) {
+	const expect Meters = 0.25
+	check := func(name string, x Meters, err error) {
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			return
+		}
+		if 1e-6 < math.Abs(float64(x-expect)) {
+			t.Errorf("%s: not valid root: %v", name, x)
+		}
+	}
+	x, err := root.Find(spring, Meters(0), Meters(1))
+	check("Find", x, err)
+
+	x, err = root.FindWith(root.Config{Method: root.FalsePosition}, spring, 0, 1)
+	check("FindWith", x, err)
+
+	x, err = root.FindEqual(spring, Newtons(0), Meters(0), Meters(1))
+	check("FindEqual", x, err)
+
+	x, err = root.FindThreshold(spring, Newtons(0), Meters(0), Meters(1), root.NegToPos)
+	check("FindThreshold", x, err)
+
+	x, _, err = root.FindResidualHistory(spring, Meters(0), Meters(1))
+	check("FindResidualHistory", x, err)
+
+	step := root.FindResumable(spring, Meters(0), Meters(1))
+	for done := false; !done; {
+		x, done, err = step()
+	}
+	check("FindResumable", x, err)
+
+	res, err := root.FindResult(spring, Meters(0), Meters(1))
+	check("FindResult", Meters(res.Root), err)
+
+	roots, _, _, _, err := root.FindAllVerbose(spring, Meters(0), Meters(1), 3)
+	if len(roots) != 1 {
+		t.Fatalf("FindAllVerbose: not valid roots: %v", roots)
+	}
+	check("FindAllVerbose", roots[0], err)
+}
+
+func TestUnitsSlope(t *testing.T) {
+	x, slope, err := root.FindWithSlope(spring, Meters(0), Meters(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 1e-6 < math.Abs(float64(x)-0.25) || 1e-6 < math.Abs(slope-200) {
+		t.Errorf("not valid root or slope: %v %v", x, slope)
+	}
+	x, err = root.FindLipschitz(spring, Meters(0), Meters(1), 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 1e-6 < math.Abs(float64(x)-0.25) {
+		t.Errorf("FindLipschitz: not valid root: %v", x)
+	}
+	x, err = root.FindConstrained(spring, func(x Meters) (bool, error) {
+		return 0 < x, nil
+	}, Meters(0), Meters(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 1e-6 < math.Abs(float64(x)-0.25) {
+		t.Errorf("FindConstrained: not valid root: %v", x)
+	}
+}
@@ -0,0 +1,63 @@
+package root
+
+import "math"
+
+// TestingT is minimal interface of testing.T used by VerifySolver
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+// SolveFunc is root-finding solver on interval [minX, maxX]
+type SolveFunc func(f func(float64) (float64, error), minX, maxX float64) (float64, error)
+
+// KnownCase is function with interval and known root.
+// If root is not known, then Root is NaN and only absolute value
+// of function in the root is checked.
+type KnownCase struct {
+	F        func(float64) (float64, error)
+	Min, Max float64
+	Root     float64
+}
+
+// VerifySolver checks solver by cases with known roots.
+//
+//	Input data:
+//		t     - testing interface for reporting
+//		solve - root-finding solver
+//		cases - cases with known roots
+//
+// For each case, found root must be:
+//   - without error
+//   - inside interval [Min, Max]
+//   - absolute value of function less Precision
+//   - near to known root with relative precision Precision
+func VerifySolver(t TestingT, solve SolveFunc, cases []KnownCase) {
+	prec, _ := parameters()
+	for i, c := range cases {
+		x, err := solve(c.F, c.Min, c.Max)
+		if err != nil {
+			t.Errorf("case %d: %v", i, err)
+			continue
+		}
+		if x < math.Min(c.Min, c.Max) || math.Max(c.Min, c.Max) < x {
+			t.Errorf("case %d: root %.3e is outside of interval [%.3e, %.3e]",
+				i, x, c.Min, c.Max)
+			continue
+		}
+		y, err := c.F(x)
+		if err != nil {
+			t.Errorf("case %d: %v", i, err)
+			continue
+		}
+		if !(math.Abs(y) < prec) {
+			t.Errorf("case %d: not valid precision: %.3e < %.3e",
+				i, prec, math.Abs(y))
+		}
+		if math.IsNaN(c.Root) {
+			continue
+		}
+		if prec*math.Max(1, math.Abs(c.Root)) < math.Abs(x-c.Root) {
+			t.Errorf("case %d: not valid root: %.3e != %.3e", i, x, c.Root)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package root_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+type counterT struct {
+	errs []string
+}
+
+func (c *counterT) Errorf(format string, args ...interface{}) {
+	c.errs = append(c.errs, fmt.Sprintf(format, args...))
+}
+
+func TestVerifySolver(t *testing.T) {
+	cases := []root.KnownCase{{
+		F: func(x float64) (float64, error) {
+			return x*x - 2, nil
+		},
+		Min:  0,
+		Max:  2,
+		Root: math.Sqrt2,
+	}}
+	root.VerifySolver(t, root.Find[float64, float64], cases)
+
+	t.Run("wrong solver", func(t *testing.T) {
+		var c counterT
+		wrong := func(f func(float64) (float64, error), minX, maxX float64) (float64, error) {
+			return maxX + 1, nil
+		}
+		root.VerifySolver(&c, wrong, cases)
+		t.Logf("%v", c.errs)
+		if len(c.errs) != 1 {
+			t.Errorf("not valid amount of errors: %d", len(c.errs))
+		}
+	})
+	t.Run("wrong root", func(t *testing.T) {
+		var c counterT
+		wrong := []root.KnownCase{cases[0]}
+		wrong[0].Root = 1.5
+		root.VerifySolver(&c, root.Find[float64, float64], wrong)
+		t.Logf("%v", c.errs)
+		if len(c.errs) != 1 {
+			t.Errorf("not valid amount of errors: %d", len(c.errs))
+		}
+	})
+}
+
+func ExampleVerifySolver() {
+	cases := []root.KnownCase{{
+		F: func(x float64) (float64, error) {
+			return math.Cos(x), nil
+		},
+		Min:  1,
+		Max:  2,
+		Root: math.Pi / 2,
+	}, {
+		F: func(x float64) (float64, error) {
+			return math.Exp(x) - 2, nil
+		},
+		Min:  0,
+		Max:  1,
+		Root: math.Ln2,
+	}, {
+		F: func(x float64) (float64, error) {
+			return x*x*x - 8, nil
+		},
+		Min:  0,
+		Max:  3,
+		Root: 2,
+	}}
+	for _, tf := range root.TestFunctions() {
+		cases = append(cases, root.KnownCase{
+			F:    tf.F,
+			Min:  tf.Min,
+			Max:  tf.Max,
+			Root: math.NaN(), // root is not known
+		})
+	}
+	var c counterT
+	root.VerifySolver(&c, root.Find[float64, float64], cases)
+	fmt.Println("errors:", len(c.errs))
+	// Output:
+	// errors: 0
+}
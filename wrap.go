@@ -0,0 +1,28 @@
+package root
+
+// Negate returns function -f(x).
+// Error of function f is returned without changes.
+func Negate[F64 ~float64, F64R ~float64](f func(F64) (F64R, error)) func(F64) (F64R, error) {
+	return func(x F64) (F64R, error) {
+		y, err := f(x)
+		return -y, err
+	}
+}
+
+// Offset returns function f(x)+c.
+// Error of function f is returned without changes.
+func Offset[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), c F64R) func(F64) (F64R, error) {
+	return func(x F64) (F64R, error) {
+		y, err := f(x)
+		return y + c, err
+	}
+}
+
+// Scale returns function k*f(x).
+// Error of function f is returned without changes.
+func Scale[F64 ~float64, F64R ~float64](f func(F64) (F64R, error), k F64R) func(F64) (F64R, error) {
+	return func(x F64) (F64R, error) {
+		y, err := f(x)
+		return k * y, err
+	}
+}
@@ -0,0 +1,71 @@
+package root_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Konstantin8105/root"
+)
+
+func TestWrap(t *testing.T) {
+	f := func(x float64) (float64, error) {
+		return x*x - 1, nil
+	}
+	t.Run("negate", func(t *testing.T) {
+		rootX, err := root.Find(root.Negate(f), 0, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-5 < math.Abs(rootX-1) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("offset", func(t *testing.T) {
+		// x*x - 1 - 3 = 0
+		rootX, err := root.Find(root.Offset(f, -3), 0, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-5 < math.Abs(rootX-2) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("scale", func(t *testing.T) {
+		rootX, err := root.Find(root.Scale(f, 1e6), 0, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-10 < math.Abs(rootX-1) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("compose", func(t *testing.T) {
+		// -2*(x*x - 1 - 8) = 0
+		g := root.Negate(root.Scale(root.Offset(f, -8), 2))
+		y, err := g(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if y != 16 {
+			t.Errorf("not valid value: %e", y)
+		}
+		rootX, err := root.Find(g, 0, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if 1e-5 < math.Abs(rootX-3) {
+			t.Errorf("not valid root: %e", rootX)
+		}
+	})
+	t.Run("error", func(t *testing.T) {
+		stop := errors.New("stop")
+		e := func(x float64) (float64, error) {
+			return 0, stop
+		}
+		_, err := root.Negate(root.Scale(root.Offset(e, 1), 2))(0)
+		if err != stop {
+			t.Errorf("error is not propagated: %v", err)
+		}
+	})
+}